@@ -2,15 +2,24 @@ package gofp
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/shful/gofp/owlfunctional/parser"
 )
 
 // ErrorMsgWithPosition produces a user message like "untempting topping on margherita pizza in: pizza.owl 18:32 after '...Pizza ObjectSomeValuesFrom(:hasTopping'"
-// for errors or type parser.PErr. For other error types, err.Error() is returned.
+// for errors or type parser.PErr. For parser.PErrs, there is one such line per error.
+// For other error types, err.Error() is returned.
 func ErrorMsgWithPosition(err error) string {
-	if perr, ok := err.(*parser.PErr); ok {
-		return fmt.Sprintf("%v in:%v", perr.Msg, ParserPositionMsg(perr.AfterPos))
+	switch err := err.(type) {
+	case *parser.PErr:
+		return fmt.Sprintf("%v in:%v", err.Msg, ParserPositionMsg(err.AfterPos))
+	case parser.PErrs:
+		msgs := make([]string, len(err))
+		for i, perr := range err {
+			msgs[i] = ErrorMsgWithPosition(perr)
+		}
+		return strings.Join(msgs, "\n")
 	}
 	return err.Error()
 }
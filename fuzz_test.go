@@ -0,0 +1,31 @@
+//go:build go1.18
+// +build go1.18
+
+package gofp
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func FuzzOntologyFromReader(f *testing.F) {
+	pizza, err := ioutil.ReadFile("example/pizza/pizza-functional.owl")
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, seed := range []string{
+		string(pizza),
+		"Prefix(:=<urn:test#>)\nOntology(<urn:test> <urn:test/1.0>\n\tImport(<urn:other>)\n\tAnnotation(rdfs:comment \"test\")\n)",
+		"Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A ObjectSomeValuesFrom(:p ObjectComplementOf(:B)))\n\tSubClassOf(:B :C)\n)",
+		"Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A :B)\n\tUnknown(:x (:y :z))\n\tSymmetricObjectProperty(:p :q)\n)",
+		"Prefix(:=<urn:test#>)\nOntology(\n\tDataPropertyAssertion(:d :x \"abc\"^^<http://www.w3.org/2001/XMLSchema#integer>)\n)",
+		"Prefix(:=<urn:test#>)\nOntology(\n\tHasKey(:Pizza (:hasBase) (:hasName))\n\tDisjointUnion(:A :B :C)\n\tNegativeObjectPropertyAssertion(ObjectInverseOf(:p) _:a :b)\n)",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		OntologyFromReader(strings.NewReader(s), "Fuzz")
+		OntologyFromReaderWithRecovery(strings.NewReader(s), "Fuzz")
+	})
+}
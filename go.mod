@@ -1,3 +1,3 @@
 module github.com/shful/gofp
 
-go 1.12
+go 1.20
@@ -19,7 +19,7 @@ package gofp
 // 	ObjectUnionOf( a:Person a:Animal a:Animal )
 // 	During parsing, this expression should be "flattened" to the following expression:
 // 	ObjectUnionOf( a:Person a:Animal )
-//  Remark: Gofp does not yet "flatten" that.
+//  Remark: Gofp "flattens" that only with parser.Parser.SetDedup. See package structural for comparing such expressions.
 
 // - A functional-style syntax ontology document SHOULD use the UTF-8 encoding [RFC 3629].
 //  Remark: For gofp, it MUST be UTF-8
@@ -28,8 +28,12 @@ package gofp
 //where axiomAnnotations := { Annotation }
 
 import (
+	"context"
+	"fmt"
 	"io"
+	"strings"
 
+	"github.com/shful/gofp/imports"
 	"github.com/shful/gofp/owlfunctional"
 	"github.com/shful/gofp/owlfunctional/parser"
 	"github.com/shful/gofp/parsehelper"
@@ -41,28 +45,131 @@ import (
 // sourceName: see parser.NewParser()
 // For less convenience but more control, see the OntologyFromParser function.
 func OntologyFromReader(r io.Reader, sourceName string) (ontology *owlfunctional.Ontology, err error) {
+	ontology, _, err = ontologyFromParserWithDefaults(parser.NewParser(r, sourceName), sourceName)
+	return
+}
+
+// OntologyFromReaderWithRecovery is like OntologyFromReader, but does not stop at the first erroneous axiom.
+// Each erroneous axiom is skipped, and all errors are returned together as parser.PErrs (see parser.Parser.SetRecovery).
+// The ontology is returned with all correct axioms, even if err is a parser.PErrs.
+func OntologyFromReaderWithRecovery(r io.Reader, sourceName string) (ontology *owlfunctional.Ontology, err error) {
+	p := parser.NewParser(r, sourceName)
+	p.SetRecovery(true)
+	ontology, _, err = ontologyFromParserWithDefaults(p, sourceName)
+	return
+}
 
+// OntologyFromReaderWithLimits is like OntologyFromReader, for untrusted input.
+// Parsing stops when ctx is done, or when the input exceeds one of the limits. The error is then a *parser.PErr
+// of the kind parser.ErrCanceled or parser.ErrLimitExceeded, see parser.Parser.SetLimits.
+func OntologyFromReaderWithLimits(ctx context.Context, r io.Reader, sourceName string, limits parser.Limits) (ontology *owlfunctional.Ontology, err error) {
 	p := parser.NewParser(r, sourceName)
+	p.SetContext(ctx)
+	p.SetLimits(limits)
+	ontology, _, err = ontologyFromParserWithDefaults(p, sourceName)
+	return
+}
+
+// OntologyFromReaderWithImports is like OntologyFromReader, but additionally loads the imports closure.
+// All ontologies which are directly or indirectly imported are parsed into the same stores as the importing ontology.
+// The resolver provides the document for each import IRI. Each IRI is loaded once only, so that cyclic imports are no problem.
+// Errors from an imported document carry the sourceName given by the resolver.
+func OntologyFromReaderWithImports(r io.Reader, sourceName string, resolver imports.Resolver) (ontology *owlfunctional.Ontology, err error) {
+	var rc owlfunctional.StoreConfig
+	ontology, rc, err = ontologyFromParserWithDefaults(parser.NewParser(r, sourceName), sourceName)
+	if err != nil {
+		return
+	}
+	err = LoadImports(ontology, resolver, rc)
+	return
+}
+
+// ontologyFromParserWithDefaults parses with p into new default stores, which are returned as rc and as ontology.K.
+// It is an error if p finds no Ontology element.
+func ontologyFromParserWithDefaults(p *parser.Parser, sourceName string) (ontology *owlfunctional.Ontology, rc owlfunctional.StoreConfig, err error) {
 	k := storedefaults.NewDefaultK()
 
-	// In this convenience method, by default, accept implicit declarations which is OWL standard
+	// In the convenience functions, by default, accept implicit declarations which is OWL standard
 	// When true, any declaration needs to be explicit written before usage, or the parser stops with a error.
 	k.ExplicitDecls = false
 
-	rc := owlfunctional.StoreConfig{
+	rc = owlfunctional.StoreConfig{
 		AxiomStore: k,
 		Decls:      k,
 		DeclStore:  k,
 	}
 	ontology, err = OntologyFromParser(p, rc)
-	if err != nil {
+	if ontology == nil {
+		if err == nil {
+			err = fmt.Errorf("no Ontology found in %v", sourceName)
+		}
 		return
 	}
 
 	// When parsing into the default structures, we can set the convenience attribute Ontology.K
 	// See package "store" for parsing into custom structures instead:
 	ontology.K = k
+	return
+}
+
+// LoadImports parses all ontologies which ontology imports, directly or indirectly, into the stores of rc.
+// The directly imported ontologies are appended to ontology.Imported.
+// An ontology is loaded once only, identified by its import IRI and by its own ontology IRI and version IRI,
+// so that it is not loaded again when imported under another IRI. The importing ontology itself is never loaded again.
+func LoadImports(ontology *owlfunctional.Ontology, resolver imports.Resolver, rc owlfunctional.StoreConfig) (err error) {
+	loaded := map[string]bool{}
+	markLoaded(loaded, ontology)
+	return loadImports(ontology, resolver, rc, loaded)
+}
+
+func loadImports(ontology *owlfunctional.Ontology, resolver imports.Resolver, rc owlfunctional.StoreConfig, loaded map[string]bool) (err error) {
+	for _, iri := range ontology.Imports {
+		if loaded[iri] {
+			continue
+		}
+		loaded[iri] = true
 
+		var imported *owlfunctional.Ontology
+		if imported, err = loadImport(iri, resolver, rc); err != nil {
+			return
+		}
+		markLoaded(loaded, imported)
+		ontology.Imported = append(ontology.Imported, imported)
+
+		if err = loadImports(imported, resolver, rc, loaded); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// markLoaded records the ontology IRI and version IRI of ontology as loaded.
+func markLoaded(loaded map[string]bool, ontology *owlfunctional.Ontology) {
+	for _, iri := range []string{ontology.IRI, ontology.VERSIONIRI} {
+		if iri != "" {
+			loaded[strings.TrimSuffix(strings.TrimPrefix(iri, "<"), ">")] = true
+		}
+	}
+}
+
+// loadImport parses the single ontology document for iri, without its imports.
+func loadImport(iri string, resolver imports.Resolver, rc owlfunctional.StoreConfig) (imported *owlfunctional.Ontology, err error) {
+	r, sourceName, err := resolver.Resolve(iri)
+	if err != nil {
+		err = fmt.Errorf("resolving import %v: %v", iri, err)
+		return
+	}
+	if c, ok := r.(io.Closer); ok {
+		defer c.Close()
+	}
+
+	imported, err = OntologyFromParser(parser.NewParser(r, sourceName), rc)
+	if err != nil {
+		return
+	}
+	if imported == nil {
+		err = fmt.Errorf("no Ontology found in %v, imported as %v", sourceName, iri)
+	}
 	return
 }
 
@@ -72,6 +179,11 @@ func OntologyFromReader(r io.Reader, sourceName string) (ontology *owlfunctional
 // Note that the API may change and Gofp, in its early state, does not use a semantic version number.
 func OntologyFromParser(p *parser.Parser, rc owlfunctional.StoreConfig) (ontology *owlfunctional.Ontology, err error) {
 	prefixes := map[string]string{}
+	defer func() {
+		if perr := p.Err(); perr != nil {
+			err = perr
+		}
+	}()
 
 	for {
 		tok, lit, pos := p.ScanIgnoreWSAndComment()
@@ -79,7 +191,7 @@ func OntologyFromParser(p *parser.Parser, rc owlfunctional.StoreConfig) (ontolog
 		case parser.Prefix:
 			p.Unscan()
 			if err = parsePrefixTo(prefixes, p); err != nil {
-				err = pos.Errorf("Parsing prefix raised:%v", err)
+				err = pos.EnrichErrorMsg(err, "Parsing prefix raised")
 				return
 			}
 		case parser.Ontology:
@@ -113,7 +225,7 @@ func parsePrefixTo(prefixes map[string]string, p *parser.Parser) (err error) {
 	} else {
 		// Prefix(IDENT:=...)
 		if tok != parser.IDENT {
-			return pos.Errorf("unexpected \"%v\" when parsing prefix, need IDENT", prefix)
+			return pos.ErrorfExpected(tok, parser.Tokenname(parser.IDENT), "unexpected \"%v\" when parsing prefix, need IDENT", prefix)
 		}
 	}
 	if err = p.ConsumeTokens(parser.COLON, parser.EQUALS); err != nil {
@@ -121,13 +233,13 @@ func parsePrefixTo(prefixes map[string]string, p *parser.Parser) (err error) {
 	}
 	prefixVal, err := parsehelper.ParseUnprefixedIRI(p)
 	if err != nil {
-		return pos.Errorf("unexpected \"%v\" when parsing prefix, need IRI", prefixVal)
+		return pos.ErrorfKind(parser.ErrUnexpectedToken, "unexpected \"%v\" when parsing prefix, need IRI", prefixVal)
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
 		return err
 	}
 	if _, ok := prefixes[prefix]; ok {
-		return pos.Errorf(`second occurrence of prefix "%v"`, prefix)
+		return pos.ErrorfKind(parser.ErrDuplicatePrefix, `second occurrence of prefix "%v"`, prefix)
 	}
 	prefixes[prefix] = prefixVal
 	return
@@ -1,13 +1,17 @@
 package gofp
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
 	"github.com/shful/gofp/mock"
 	"github.com/shful/gofp/owlfunctional"
 	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/storedefaults"
 )
 
 func TestParsePrefixTo(t *testing.T) {
@@ -238,3 +242,234 @@ Ontology(<urn:absolute:test.de>
 		t.Fatal(pos)
 	}
 }
+
+// mapResolver is an imports.Resolver which takes the ontology documents from a map.
+type mapResolver map[string]string
+
+func (s mapResolver) Resolve(iri string) (io.Reader, string, error) {
+	doc, ok := s[iri]
+	if !ok {
+		return nil, "", fmt.Errorf("no document for %v", iri)
+	}
+	return strings.NewReader(doc), "doc-" + iri, nil
+}
+
+func TestOntologyFromReaderWithImports(t *testing.T) {
+	var err error
+	var o *owlfunctional.Ontology
+
+	resolver := mapResolver{
+		"urn:pizza": `
+Prefix(:=<urn:pizza#>)
+Ontology(<urn:pizza>
+	Import(<urn:food>)
+	Declaration(Class(:Pizza))
+	SubClassOf(:Pizza <urn:food#Food>)
+)`,
+		"urn:food": `
+Prefix(:=<urn:food#>)
+Ontology(<urn:food>
+	# cyclic import back to the importing ontology
+	Import(<urn:main>)
+	Declaration(Class(:Food))
+)`,
+	}
+
+	o, err = OntologyFromReaderWithImports(strings.NewReader(`
+Prefix(:=<urn:main#>)
+Prefix(p:=<urn:pizza#>)
+Ontology(<urn:main>
+	Import(<urn:pizza>)
+	Import(<urn:food>)
+	SubClassOf(:Margherita p:Pizza)
+)`), "Testsource", resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Imports) != 2 || o.Imports[0] != "urn:pizza" || o.Imports[1] != "urn:food" {
+		t.Fatal(o.Imports)
+	}
+	// urn:food was loaded as import of urn:pizza already:
+	if len(o.Imported) != 1 || o.Imported[0].IRI != "<urn:pizza>" {
+		t.Fatal(o.Imported)
+	}
+	if len(o.Imported[0].Imported) != 1 || o.Imported[0].Imported[0].IRI != "<urn:food>" {
+		t.Fatal(o.Imported[0].Imported)
+	}
+	if len(o.K.AllSubClassOfs()) != 2 {
+		t.Fatal(o.K.AllSubClassOfs())
+	}
+	if _, ok := o.K.ClassDecl("urn:food#Food"); !ok {
+		t.Fatal(o.K.AllClassDecls())
+	}
+
+	// an ontology which was loaded under another import IRI is identified by its ontology IRI:
+	resolver["urn:food-latest"] = resolver["urn:food"]
+	o, err = OntologyFromReaderWithImports(strings.NewReader(`Ontology(<urn:main> Import(<urn:food-latest>) Import(<urn:pizza>))`), "Testsource", resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Imported) != 2 || o.Imported[0].IRI != "<urn:food>" || len(o.Imported[1].Imported) != 0 {
+		t.Fatal(o.Imported, o.Imported[1].Imported)
+	}
+
+	// errors in an imported document are reported with the source name of that document:
+	resolver["urn:food"] = `
+Ontology(<urn:food>
+	X
+)`
+	_, err = OntologyFromReaderWithImports(strings.NewReader(`Ontology(<urn:main> Import(<urn:food>))`), "Testsource", resolver)
+	if err == nil {
+		t.Fatal()
+	}
+	pos := err.(*parser.PErr).AfterPos
+	if pos.SourceName() != "doc-urn:food" || pos.LineNo1() != 3 {
+		t.Fatal(err)
+	}
+
+	// an unresolvable import is an error:
+	_, err = OntologyFromReaderWithImports(strings.NewReader(`Ontology(<urn:main> Import(<urn:unknown>))`), "Testsource", resolver)
+	if err == nil {
+		t.Fatal()
+	}
+}
+
+func TestOntologyFromReaderWithRecovery(t *testing.T) {
+	o, err := OntologyFromReaderWithRecovery(strings.NewReader(`Prefix(:=<urn:test#>)
+Ontology(<urn:test>
+	SubClassOf(:A :B)
+	SubClassOf(:A ObjectSomeValuesFrom(:p))
+	SubClassOf(:B :C)
+	Unknown(:x (:y :z))
+	SymmetricObjectProperty(:p :q)
+	SubClassOf(:C :D)
+	SubClassOf(:D)
+	SubClassOf(:D :E)
+)`), "Testsource")
+	perrs, ok := err.(parser.PErrs)
+	if !ok {
+		t.Fatal(err)
+	}
+	if len(perrs) != 4 {
+		t.Fatal(ErrorMsgWithPosition(err))
+	}
+	for i, lineNo1 := range []int{4, 6, 7, 9} {
+		if perrs[i].AfterPos.LineNo1() != lineNo1 {
+			t.Fatal(i, ErrorMsgWithPosition(perrs[i]))
+		}
+	}
+	if o == nil || o.K == nil || len(o.K.AllSubClassOfs()) != 4 {
+		t.Fatal(o)
+	}
+	if n := strings.Count(ErrorMsgWithPosition(err), "\n"); n != 3 {
+		t.Fatal(ErrorMsgWithPosition(err))
+	}
+	if !errors.Is(err, parser.ErrUnexpectedToken) || !errors.Is(err, parser.ErrParamCount) || errors.Is(err, parser.ErrUnexpectedEOF) {
+		t.Fatal(err)
+	}
+
+	// no Ontology element
+	if o, err = OntologyFromReaderWithRecovery(strings.NewReader(`Prefix(:=<urn:test#>)`), "Testsource"); err == nil || o != nil {
+		t.Fatal(o, err)
+	}
+}
+
+func TestOntologyFromReaderWithRecoveryEOF(t *testing.T) {
+	_, err := OntologyFromReaderWithRecovery(strings.NewReader(`Ontology(
+	SubClassOf(:A ObjectSomeValuesFrom(:p)
+`), "Testsource")
+	perrs, ok := err.(parser.PErrs)
+	if !ok || len(perrs) != 2 {
+		t.Fatal(err)
+	}
+}
+
+func TestErrorKinds(t *testing.T) {
+	for _, c := range []struct {
+		ontology string
+		kind     parser.ErrorKind
+	}{
+		{"Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A x:B)\n)", parser.ErrUnknownPrefix},
+		{"Prefix(:=<urn:test#>)\nPrefix(:=<urn:other#>)\nOntology()", parser.ErrDuplicatePrefix},
+		{"Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A)\n)", parser.ErrParamCount},
+		{"Prefix(:=<urn:test#>)\nOntology(\n\tClassAssertion(:A :x)\n\tDataPropertyAssertion(:d :x \"abc\"^^<http://www.w3.org/2001/XMLSchema#integer>)\n)", parser.ErrLiteralTypeMismatch},
+		{"Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A :B\n", parser.ErrUnexpectedEOF},
+		{"Prefix(:=<urn:test#>)\nOntology(\n\t\"text\"\n)", parser.ErrUnexpectedToken},
+	} {
+		_, err := OntologyFromReader(strings.NewReader(c.ontology), "Testsource")
+		if !errors.Is(err, c.kind) {
+			t.Fatalf("expected %v, got %v", c.kind, err)
+		}
+		var perr *parser.PErr
+		if !errors.As(err, &perr) || perr.Kind != c.kind {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestErrorUndeclared(t *testing.T) {
+	p := parser.NewParser(strings.NewReader("Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A :B)\n)"), "Testsource")
+	k := storedefaults.NewDefaultK()
+	_, err := OntologyFromParser(p, owlfunctional.StoreConfig{AxiomStore: k, Decls: k, DeclStore: k})
+	var perr *parser.PErr
+	if !errors.As(err, &perr) || perr.Kind != parser.ErrUndeclaredEntity {
+		t.Fatal(err)
+	}
+	if perr.AfterPos.LineNo1() != 3 || perr.AfterPos.ColNo1() != 13 || perr.AfterPos.Offset() != 44 {
+		t.Fatal(perr.AfterPos.LineNo1(), perr.AfterPos.ColNo1(), perr.AfterPos.Offset())
+	}
+}
+
+func TestErrorExpected(t *testing.T) {
+	_, err := OntologyFromReader(strings.NewReader("Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A 42)\n)"), "Testsource")
+	var perr *parser.PErr
+	if !errors.As(err, &perr) || perr.Kind != parser.ErrUnexpectedToken || len(perr.Expected) != 1 || perr.Expected[0] != "class expression" {
+		t.Fatal(err)
+	}
+}
+
+func TestOntologyFromReaderWithLimits(t *testing.T) {
+	ontology := "Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A ObjectSomeValuesFrom(:p ObjectComplementOf(:B)))\n\tSubClassOf(:B :C)\n)"
+
+	o, err := OntologyFromReaderWithLimits(context.Background(), strings.NewReader(ontology), "Testsource", parser.Limits{MaxBytes: int64(len(ontology)), MaxTokens: 100, MaxAxioms: 2, MaxDepth: 4})
+	if err != nil || len(o.K.AllSubClassOfs()) != 2 {
+		t.Fatal(err)
+	}
+
+	for _, limits := range []parser.Limits{
+		{MaxBytes: int64(len(ontology)) - 1},
+		{MaxTokens: 20},
+		{MaxAxioms: 1},
+		{MaxDepth: 3},
+	} {
+		_, err := OntologyFromReaderWithLimits(context.Background(), strings.NewReader(ontology), "Testsource", limits)
+		if !errors.Is(err, parser.ErrLimitExceeded) {
+			t.Fatal(limits, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = OntologyFromReaderWithLimits(ctx, strings.NewReader(ontology), "Testsource", parser.Limits{})
+	if !errors.Is(err, parser.ErrCanceled) || !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+
+	// no Ontology element
+	if _, err = OntologyFromReaderWithLimits(context.Background(), strings.NewReader("Prefix(:=<urn:test#>)"), "Testsource", parser.Limits{}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestLimitsWithRecovery(t *testing.T) {
+	p := parser.NewParser(strings.NewReader("Prefix(:=<urn:test#>)\nOntology(\n\tSubClassOf(:A)\n\tSubClassOf(:A ObjectComplementOf(ObjectComplementOf(:B)))\n)"), "Testsource")
+	p.SetRecovery(true)
+	p.SetLimits(parser.Limits{MaxDepth: 3})
+	k := storedefaults.NewDefaultK()
+	k.ExplicitDecls = false
+	_, err := OntologyFromParser(p, owlfunctional.StoreConfig{AxiomStore: k, Decls: k, DeclStore: k})
+	var perr *parser.PErr
+	if !errors.As(err, &perr) || perr.Kind != parser.ErrLimitExceeded || perr.AfterPos.LineNo1() != 4 {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+// imports resolves the IRIs found in Import(...) statements to ontology document contents.
+package imports
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Resolver gives access to the document of an imported ontology.
+// See gofp.OntologyFromReaderWithImports, which uses a Resolver to load the imports closure.
+type Resolver interface {
+	// Resolve returns the OWL-Functional contents for the ontology IRI iri.
+	// sourceName identifies the contents in error messages, like the sourceName in parser.NewParser().
+	// If r is also an io.Closer, the caller closes it after parsing.
+	Resolve(iri string) (r io.Reader, sourceName string, err error)
+}
+
+// DirResolver resolves imports to files in a local directory.
+// Catalog maps an import IRI to a filename. Relative filenames are taken relative to Dir.
+// IRIs which are not in Catalog are mapped to the last path segment of the IRI,
+// e.g. "http://example.com/ontologies/pizza.owl" to the file "pizza.owl" in Dir.
+type DirResolver struct {
+	Dir     string
+	Catalog map[string]string
+}
+
+var _ Resolver = (*DirResolver)(nil)
+
+// NewDirResolver returns a DirResolver with an empty catalog.
+func NewDirResolver(dir string) *DirResolver {
+	return &DirResolver{Dir: dir, Catalog: map[string]string{}}
+}
+
+func (s *DirResolver) Resolve(iri string) (r io.Reader, sourceName string, err error) {
+	var filename string
+	filename, err = s.Filename(iri)
+	if err != nil {
+		return
+	}
+	var f *os.File
+	f, err = os.Open(filename)
+	if err != nil {
+		return
+	}
+	return f, filename, nil
+}
+
+// Filename returns the name of the file where Resolve looks for the ontology iri.
+func (s *DirResolver) Filename(iri string) (filename string, err error) {
+	var ok bool
+	if filename, ok = s.Catalog[iri]; !ok {
+		var u *url.URL
+		u, err = url.Parse(iri)
+		if err != nil {
+			return
+		}
+		filename = path.Base(u.Path)
+		if filename == "/" || filename == "." {
+			err = fmt.Errorf("cannot derive a filename from import IRI %v", iri)
+			return
+		}
+	}
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(s.Dir, filename)
+	}
+	return
+}
@@ -2,7 +2,11 @@
 package mock
 
 import (
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
+	"testing"
 
 	"github.com/shful/gofp/owlfunctional/parser"
 	"github.com/shful/gofp/store"
@@ -81,3 +85,14 @@ func (s *Builder) AddObjectPropertyDecl(ident tech.IRI) *Builder {
 func NewTestParser(owl string) *parser.Parser {
 	return parser.NewParser(strings.NewReader(owl), "Testparser")
 }
+
+// Testdata returns the content of the file name in the testdata directory of the repository,
+// which holds the fixtures shared by the tests of several packages.
+func Testdata(t testing.TB, name string) string {
+	_, file, _, _ := runtime.Caller(0)
+	b, err := os.ReadFile(filepath.Join(filepath.Dir(file), "..", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
@@ -0,0 +1,26 @@
+// mockontology parses ontologies for the tests of the writers and renderers.
+// It is apart from package mock, which the tests of the parser packages use, since it depends on the parser.
+package mockontology
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/mock"
+	"github.com/shful/gofp/owlfunctional"
+)
+
+// Parse parses the OWL-Functional text with the default stores. An error fails t.
+func Parse(t testing.TB, text string) *owlfunctional.Ontology {
+	o, err := gofp.OntologyFromReader(strings.NewReader(text), "test")
+	if err != nil {
+		t.Fatal(err, "\n", text)
+	}
+	return o
+}
+
+// Pizza parses the pizza ontology of testdata/pizza.ofn.
+func Pizza(t testing.TB) *owlfunctional.Ontology {
+	return Parse(t, mock.Testdata(t, "pizza.ofn"))
+}
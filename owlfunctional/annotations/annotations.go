@@ -5,8 +5,9 @@ import (
 )
 
 type Annotation struct {
-	a meta.AnnotationProperty
-	t string
+	a          meta.AnnotationProperty
+	t          string
+	documentID int64
 }
 
 var _ meta.Annotation = (*Annotation)(nil)
@@ -18,6 +19,16 @@ func NewAnnotation(a meta.AnnotationProperty, t string) *Annotation {
 	}
 }
 
+// NewAnonymousAnnotation returns an Annotation whose value is the anonymous individual nodeID,
+// found in the document with documentID.
+func NewAnonymousAnnotation(a meta.AnnotationProperty, nodeID string, documentID int64) *Annotation {
+	return &Annotation{
+		a:          a,
+		t:          nodeID,
+		documentID: documentID,
+	}
+}
+
 func (s *Annotation) A() meta.AnnotationProperty {
 	return s.a
 }
@@ -26,6 +37,10 @@ func (s *Annotation) T() string {
 	return s.t
 }
 
+func (s *Annotation) DocumentID() int64 {
+	return s.documentID
+}
+
 type AnnotationAssertion struct {
 	A meta.AnnotationProperty
 
@@ -35,6 +50,10 @@ type AnnotationAssertion struct {
 	// langTag can be set on strings. The literal "foo"@en results in langTag="en".
 	// If not given, langTag is empty. For non-string types, it is also empty.
 	T string
+
+	// DocumentID is the document of S and T if one of them is the node ID of an anonymous individual,
+	// see individual.Individual.DocumentID. It is 0 otherwise.
+	DocumentID int64
 }
 
 type AnnotationPropertyDomain struct {
@@ -2,9 +2,16 @@ package assertions
 
 import (
 	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
 	"github.com/shful/gofp/owlfunctional/meta"
 )
 
+type NegativeDataPropertyAssertion struct {
+	R meta.DataProperty
+	A individual.Individual
+	V literal.OWLLiteral
+}
+
 type NegativeObjectPropertyAssertion struct {
 	P  meta.ObjectPropertyExpression
 	A1 individual.Individual
@@ -12,7 +19,7 @@ type NegativeObjectPropertyAssertion struct {
 }
 
 type ObjectPropertyAssertion struct {
-	PN string
+	P  meta.ObjectPropertyExpression
 	A1 individual.Individual
 	A2 individual.Individual
 }
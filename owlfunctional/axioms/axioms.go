@@ -18,6 +18,12 @@ type DataPropertyRange struct {
 	D meta.DataRange
 }
 
+// DatatypeDefinition defines the datatype DN as synonym for the data range D.
+type DatatypeDefinition struct {
+	DN meta.NamedDatatype
+	D  meta.DataRange
+}
+
 // SubClassOf states C1 is subclass of C2
 type SubClassOf struct {
 	C1 meta.ClassExpression
@@ -32,6 +38,14 @@ type EquivalentClasses struct {
 	EquivalentClasses []meta.ClassExpression //todo is there a min len in OWL ?
 }
 
+// HasKey states that each named instance of C is uniquely identified by the values of
+// the object properties Ps and the data properties Rs.
+type HasKey struct {
+	C  meta.ClassExpression
+	Ps []meta.ObjectPropertyExpression
+	Rs []meta.DataProperty
+}
+
 type DifferentIndividuals struct {
 	As []individual.Individual
 }
@@ -40,6 +54,36 @@ type DisjointClasses struct {
 	DisjointClasses []meta.ClassExpression //todo is there a min len in OWL ?
 }
 
+// DisjointUnion states that the named class CN is the union of the pairwise disjoint classes in DisjointClasses.
+type DisjointUnion struct {
+	CN              meta.ClassExpression
+	DisjointClasses []meta.ClassExpression
+}
+
+type SameIndividual struct {
+	As []individual.Individual
+}
+
+// DisjointDataProperties states that no individual is connected to the same literal by any two of the data properties Rs.
+type DisjointDataProperties struct {
+	Rs []meta.DataProperty
+}
+
+// DisjointObjectProperties states that no two individuals are connected by any two of the object properties Ps.
+type DisjointObjectProperties struct {
+	Ps []meta.ObjectPropertyExpression
+}
+
+// EquivalentDataProperties states that all the data properties Rs are semantically equivalent.
+type EquivalentDataProperties struct {
+	Rs []meta.DataProperty
+}
+
+// EquivalentObjectProperties states that all the object properties Ps are semantically equivalent.
+type EquivalentObjectProperties struct {
+	Ps []meta.ObjectPropertyExpression
+}
+
 // SubDataPropertyOf defines P1 subPropertyOf P2
 type SubDataPropertyOf struct {
 	P1 meta.DataProperty
@@ -60,6 +104,17 @@ func (s *SubObjectPropertyOf) String() string {
 	return fmt.Sprintf("SOPO{%v %v}", s.P1, s.P2)
 }
 
+// SubObjectPropertyChainOf defines the chain of properties P1 o P2 o ... o Pn as subPropertyOf P.
+// It is parsed from SubObjectPropertyOf(ObjectPropertyChain(P1 ... Pn) P)
+type SubObjectPropertyChainOf struct {
+	Chain []meta.ObjectPropertyExpression
+	P     meta.ObjectPropertyExpression
+}
+
+func (s *SubObjectPropertyChainOf) String() string {
+	return fmt.Sprintf("SOPCO{%v %v}", s.Chain, s.P)
+}
+
 // InverseObjectProperties defines P1 and P2 are inverse.
 // InverseObjectProperties(P1,P2) implies InverseObjectProperties(P2,P1)
 type InverseObjectProperties struct {
@@ -95,3 +150,46 @@ type DataPropertyAssertion struct {
 	A individual.Individual
 	V literal.OWLLiteral
 }
+
+// The object and data property characteristics are stored as the bare property (e.g. see store.AxiomStore.StoreFunctionalObjectProperty).
+// The following types wrap the property, where an axiom value is needed, e.g. for visit.Walk.
+
+// FunctionalObjectProperty states that each individual has at most one P successor.
+type FunctionalObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// InverseFunctionalObjectProperty states that each individual has at most one P predecessor.
+type InverseFunctionalObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// ReflexiveObjectProperty states that each individual is connected to itself by P.
+type ReflexiveObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// IrreflexiveObjectProperty states that no individual is connected to itself by P.
+type IrreflexiveObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// SymmetricObjectProperty states that P(x,y) implies P(y,x).
+type SymmetricObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// AsymmetricObjectProperty states that P(x,y) excludes P(y,x).
+type AsymmetricObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// TransitiveObjectProperty states that P(x,y) and P(y,z) imply P(x,z).
+type TransitiveObjectProperty struct {
+	P meta.ObjectPropertyExpression
+}
+
+// FunctionalDataProperty states that each individual has at most one R value.
+type FunctionalDataProperty struct {
+	R meta.DataProperty
+}
@@ -19,9 +19,9 @@ const (
 var BuiltinDatatypes map[string]parser.Token = map[string]parser.Token{
 	PRE_OWL + "rational":           parser.FLOATLIT,
 	PRE_OWL + "real":               parser.FLOATLIT,
-	PRE_RDF + "#PlainLiteral":      parser.STRINGLIT,
-	PRE_RDF + "#XMLLiteral":        parser.STRINGLIT,
-	PRE_RDFS + "#Literal":          parser.STRINGLIT,
+	PRE_RDF + "PlainLiteral":       parser.STRINGLIT,
+	PRE_RDF + "XMLLiteral":         parser.STRINGLIT,
+	PRE_RDFS + "Literal":           parser.STRINGLIT,
 	PRE_XSD + "anyURI":             parser.STRINGLIT,
 	PRE_XSD + "base64Binary":       parser.STRINGLIT,
 	PRE_XSD + "boolean":            parser.STRINGLIT,
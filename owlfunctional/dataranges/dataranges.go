@@ -0,0 +1,46 @@
+package dataranges
+
+import (
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+)
+
+type DataComplementOf struct {
+	D meta.DataRange
+}
+
+var _ meta.DataRange = (*DataComplementOf)(nil)
+
+func (s *DataComplementOf) IsNamedDatatype() bool {
+	return false
+}
+
+type DataIntersectionOf struct {
+	Ds []meta.DataRange
+}
+
+var _ meta.DataRange = (*DataIntersectionOf)(nil)
+
+func (s *DataIntersectionOf) IsNamedDatatype() bool {
+	return false
+}
+
+type DataOneOf struct {
+	Vs []literal.OWLLiteral
+}
+
+var _ meta.DataRange = (*DataOneOf)(nil)
+
+func (s *DataOneOf) IsNamedDatatype() bool {
+	return false
+}
+
+type DataUnionOf struct {
+	Ds []meta.DataRange
+}
+
+var _ meta.DataRange = (*DataUnionOf)(nil)
+
+func (s *DataUnionOf) IsNamedDatatype() bool {
+	return false
+}
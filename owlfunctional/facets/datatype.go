@@ -21,9 +21,40 @@ type BuiltinDatatype struct {
 // CustomNamedDatatype is any NamedDatatype which is not a BuiltinDatatype.
 type CustomNamedDatatype struct {
 	NamedDatatypeImpl
+
+	// definitions resolves the DatatypeDefinition of this datatype, see Definition. nil if unknown.
+	definitions Definitions
+}
+
+// Definitions gives the data range of the DatatypeDefinition axiom for a datatype IRI, like store.DatatypeDefinitions.
+type Definitions interface {
+	DatatypeDefinition(iri string) (meta.DataRange, bool)
+}
+
+// NewCustomNamedDatatype returns the datatype with the given IRI, whose definition is looked up in definitions, which may be nil.
+func NewCustomNamedDatatype(iri string, definitions Definitions) *CustomNamedDatatype {
+	return &CustomNamedDatatype{NamedDatatypeImpl: NamedDatatypeImpl{DatatypeIRI: iri}, definitions: definitions}
 }
 
+// Definition returns the data range from the DatatypeDefinition axiom of this datatype, or nil if there is none.
+// The definition is looked up on each call, so that it is found also when the DatatypeDefinition axiom
+// comes after the first use of the datatype.
+func (s *CustomNamedDatatype) Definition() meta.DataRange {
+	if s.definitions == nil {
+		return nil
+	}
+	D, _ := s.definitions.DatatypeDefinition(s.DatatypeIRI)
+	return D
+}
+
+// DatatypeRestriction restricts the value space of the datatype DN by one or more (facet, value) pairs.
 type DatatypeRestriction struct {
 	DN      meta.NamedDatatype
 	FVPairs []*FVPair
 }
+
+var _ meta.DataRange = (*DatatypeRestriction)(nil)
+
+func (s *DatatypeRestriction) IsNamedDatatype() bool {
+	return false
+}
@@ -1,6 +1,9 @@
 package facets
 
-import "github.com/shful/gofp/owlfunctional/literal"
+import (
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/literal"
+)
 
 type Facet int
 
@@ -9,9 +12,46 @@ const (
 	Xsd_maxInclusive
 	Xsd_minExclusive
 	Xsd_maxExclusive
-	//todo more Facets
+	Xsd_length
+	Xsd_minLength
+	Xsd_maxLength
+	Xsd_pattern
+	Rdf_langRange
+	Xsd_totalDigits
+	Xsd_fractionDigits
 )
 
+// facetIRIs are the IRIs of all facets known in OWL 2.
+var facetIRIs = map[Facet]string{
+	Xsd_minInclusive:   builtindatatypes.PRE_XSD + "minInclusive",
+	Xsd_maxInclusive:   builtindatatypes.PRE_XSD + "maxInclusive",
+	Xsd_minExclusive:   builtindatatypes.PRE_XSD + "minExclusive",
+	Xsd_maxExclusive:   builtindatatypes.PRE_XSD + "maxExclusive",
+	Xsd_length:         builtindatatypes.PRE_XSD + "length",
+	Xsd_minLength:      builtindatatypes.PRE_XSD + "minLength",
+	Xsd_maxLength:      builtindatatypes.PRE_XSD + "maxLength",
+	Xsd_pattern:        builtindatatypes.PRE_XSD + "pattern",
+	Rdf_langRange:      builtindatatypes.PRE_RDF + "langRange",
+	Xsd_totalDigits:    builtindatatypes.PRE_XSD + "totalDigits",
+	Xsd_fractionDigits: builtindatatypes.PRE_XSD + "fractionDigits",
+}
+
+// IRI is the full IRI of the facet, e.g. "http://www.w3.org/2001/XMLSchema#minInclusive"
+func (f Facet) IRI() string {
+	return facetIRIs[f]
+}
+
+// FacetByIRI returns the facet with the full IRI iri.
+// false if iri is no known facet.
+func FacetByIRI(iri string) (f Facet, ok bool) {
+	for f, facetIRI := range facetIRIs {
+		if facetIRI == iri {
+			return f, true
+		}
+	}
+	return
+}
+
 type FVPair struct {
 	F Facet
 	V literal.OWLLiteral
@@ -0,0 +1,440 @@
+// hierarchy is an index of the told class hierarchy, i.e. the subclass relations between named classes which
+// are written in the ontology, without reasoning. See New for the axioms which are considered.
+package hierarchy
+
+import (
+	"sort"
+
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Hierarchy is the told class hierarchy of the named classes (decl.ClassDecl).
+// Classes which are subclasses of each other, e.g. by EquivalentClasses, form an equivalence set, which is a single node of the hierarchy.
+// The nodes form a directed acyclic graph, where owl:Thing is the implicit top node above all roots.
+// All methods return classes in the order of storedefaults.K.AllClassDecls, and classes are identified by their IRI.
+// A Hierarchy is not updated when the store changes.
+type Hierarchy struct {
+	classes []*decl.ClassDecl
+	index   map[string]int // class IRI to its index in classes
+
+	node     []int   // class index to node
+	members  [][]int // node to class indices, ascending
+	parents  [][]int // node to direct parent nodes
+	children [][]int // node to direct child nodes
+	cyclic   []bool  // node is an equivalence set from a cycle of SubClassOf axioms
+}
+
+// New builds the told hierarchy of all classes in k. It considers these axioms, where A and B are named classes:
+//
+// - SubClassOf(A B), and SubClassOf(A ObjectIntersectionOf(B ...)) which makes A a subclass of each named operand,
+// - EquivalentClasses(A B ...), where an ObjectIntersectionOf operand makes A a subclass of each of its named operands,
+// - DisjointUnion(A B ...), which makes B a subclass of A.
+//
+// Other class expressions, like restrictions, are ignored.
+func New(k storedefaults.K) *Hierarchy {
+	h := &Hierarchy{classes: k.AllClassDecls(), index: map[string]int{}}
+	for i, C := range h.classes {
+		h.index[C.IRI] = i
+	}
+
+	// told edges from subclass to superclass, by class index
+	sup := make([][]int, len(h.classes))
+	var subClassOfs [][2]int
+	// equivalent is a union-find of the classes which are equivalent by EquivalentClasses axioms
+	equivalent := make([]int, len(h.classes))
+	for i := range equivalent {
+		equivalent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if equivalent[i] != i {
+			equivalent[i] = find(equivalent[i])
+		}
+		return equivalent[i]
+	}
+	add := func(sub, super int) {
+		if sub != super {
+			sup[sub] = append(sup[sub], super)
+		}
+	}
+	for _, ax := range k.AllSubClassOfs() {
+		if sub, ok := h.classIndex(ax.C1); ok {
+			for _, super := range h.namedConjuncts(ax.C2) {
+				add(sub, super)
+				subClassOfs = append(subClassOfs, [2]int{sub, super})
+			}
+		}
+	}
+	for _, ax := range k.AllEquivalentClasses() {
+		var named []int
+		for _, C := range ax.EquivalentClasses {
+			if i, ok := h.classIndex(C); ok {
+				named = append(named, i)
+			}
+		}
+		for j := 1; j < len(named); j++ {
+			add(named[j-1], named[j])
+			add(named[j], named[j-1])
+			equivalent[find(named[j])] = find(named[j-1])
+		}
+		for _, C := range ax.EquivalentClasses {
+			if _, ok := C.(*classexpression.ObjectIntersectionOf); ok {
+				for _, sub := range named {
+					for _, super := range h.namedConjuncts(C) {
+						add(sub, super)
+					}
+				}
+			}
+		}
+	}
+	for _, ax := range k.AllDisjointUnions() {
+		if super, ok := h.classIndex(ax.CN); ok {
+			for _, C := range ax.DisjointClasses {
+				if sub, ok := h.classIndex(C); ok {
+					add(sub, super)
+				}
+			}
+		}
+	}
+
+	h.condense(sup)
+	// a SubClassOf axiom is part of a cycle if its classes are in the same node, but not equivalent by EquivalentClasses already,
+	// i.e. the classes of each EquivalentClasses axiom count as a single class here
+	for _, e := range subClassOfs {
+		if n := h.node[e[0]]; n == h.node[e[1]] && find(e[0]) != find(e[1]) {
+			h.cyclic[n] = true
+		}
+	}
+	h.reduce(sup)
+	return h
+}
+
+// classIndex returns the index of C, if C is a named class.
+func (h *Hierarchy) classIndex(C meta.ClassExpression) (int, bool) {
+	if C, ok := C.(*decl.ClassDecl); ok {
+		i, ok := h.index[C.IRI]
+		return i, ok
+	}
+	return 0, false
+}
+
+// namedConjuncts returns the named classes of C, which is a named class or an intersection.
+func (h *Hierarchy) namedConjuncts(C meta.ClassExpression) (res []int) {
+	if i, ok := h.classIndex(C); ok {
+		return []int{i}
+	}
+	if C, ok := C.(*classexpression.ObjectIntersectionOf); ok {
+		for _, op := range C.Cs {
+			res = append(res, h.namedConjuncts(op)...)
+		}
+	}
+	return
+}
+
+// condense sets the nodes as the strongly connected components of the graph sup (Tarjan's algorithm).
+// The nodes are numbered in the order of their first class.
+func (h *Hierarchy) condense(sup [][]int) {
+	n := len(h.classes)
+	h.node = make([]int, n)
+	order := make([]int, n) // DFS order + 1, 0 when unvisited
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	var stack []int
+	var components [][]int
+	counter := 0
+
+	var connect func(v int)
+	connect = func(v int) {
+		counter++
+		order[v], low[v] = counter, counter
+		stack = append(stack, v)
+		onStack[v] = true
+		for _, w := range sup[v] {
+			if order[w] == 0 {
+				connect(w)
+				if low[w] < low[v] {
+					low[v] = low[w]
+				}
+			} else if onStack[w] && order[w] < low[v] {
+				low[v] = order[w]
+			}
+		}
+		if low[v] == order[v] {
+			var c []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				c = append(c, w)
+				if w == v {
+					break
+				}
+			}
+			sort.Ints(c)
+			components = append(components, c)
+		}
+	}
+	for v := 0; v < n; v++ {
+		if order[v] == 0 {
+			connect(v)
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool { return components[i][0] < components[j][0] })
+	h.members = components
+	for node, c := range components {
+		for _, i := range c {
+			h.node[i] = node
+		}
+	}
+	h.parents = make([][]int, len(components))
+	h.children = make([][]int, len(components))
+	h.cyclic = make([]bool, len(components))
+}
+
+// reduce sets the direct parents and children of each node, which are the told superclasses
+// without those which are also superclasses of another told superclass.
+func (h *Hierarchy) reduce(sup [][]int) {
+	told := make([]map[int]bool, len(h.members))
+	for node, c := range h.members {
+		told[node] = map[int]bool{}
+		for _, i := range c {
+			for _, j := range sup[i] {
+				if p := h.node[j]; p != node {
+					told[node][p] = true
+				}
+			}
+		}
+	}
+	for node := range h.members {
+		// the ancestors of the told parents are no direct parents
+		indirect := map[int]bool{}
+		for p := range told[node] {
+			for _, a := range h.ancestorNodes(told, p) {
+				indirect[a] = true
+			}
+		}
+		for p := range told[node] {
+			if !indirect[p] {
+				h.parents[node] = append(h.parents[node], p)
+				h.children[p] = append(h.children[p], node)
+			}
+		}
+	}
+	for node := range h.members {
+		sort.Ints(h.parents[node])
+		sort.Ints(h.children[node])
+	}
+}
+
+// ancestorNodes returns all nodes above node in the graph g, excluding node itself.
+func (h *Hierarchy) ancestorNodes(g []map[int]bool, node int) (res []int) {
+	seen := map[int]bool{node: true}
+	todo := []int{node}
+	for len(todo) > 0 {
+		n := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+		for p := range g[n] {
+			if !seen[p] {
+				seen[p] = true
+				res = append(res, p)
+				todo = append(todo, p)
+			}
+		}
+	}
+	return
+}
+
+// lookup returns the node of C. ok is false if C is not a class of the hierarchy.
+func (h *Hierarchy) lookup(C *decl.ClassDecl) (node int, ok bool) {
+	if C == nil {
+		return 0, false
+	}
+	i, ok := h.index[C.IRI]
+	if !ok {
+		return 0, false
+	}
+	return h.node[i], true
+}
+
+// closure returns the nodes which are reachable from node in g, excluding node itself, in ascending order.
+func closure(g [][]int, node int) []int {
+	seen := map[int]bool{node: true}
+	var res []int
+	todo := []int{node}
+	for len(todo) > 0 {
+		n := todo[len(todo)-1]
+		todo = todo[:len(todo)-1]
+		for _, m := range g[n] {
+			if !seen[m] {
+				seen[m] = true
+				res = append(res, m)
+				todo = append(todo, m)
+			}
+		}
+	}
+	sort.Ints(res)
+	return res
+}
+
+// classesOf returns the classes of nodes, in the order of the store.
+func (h *Hierarchy) classesOf(nodes []int) []*decl.ClassDecl {
+	var is []int
+	for _, node := range nodes {
+		is = append(is, h.members[node]...)
+	}
+	sort.Ints(is)
+	res := make([]*decl.ClassDecl, len(is))
+	for j, i := range is {
+		res[j] = h.classes[i]
+	}
+	return res
+}
+
+// DirectSuperClasses returns the classes directly above C, without owl:Thing. Classes which are equivalent to C are not included.
+func (h *Hierarchy) DirectSuperClasses(C *decl.ClassDecl) []*decl.ClassDecl {
+	node, ok := h.lookup(C)
+	if !ok {
+		return nil
+	}
+	return h.classesOf(h.parents[node])
+}
+
+// SuperClasses returns all classes above C, directly or indirectly, without owl:Thing and without the classes which are equivalent to C.
+func (h *Hierarchy) SuperClasses(C *decl.ClassDecl) []*decl.ClassDecl {
+	node, ok := h.lookup(C)
+	if !ok {
+		return nil
+	}
+	return h.classesOf(closure(h.parents, node))
+}
+
+// DirectSubClasses returns the classes directly below C.
+func (h *Hierarchy) DirectSubClasses(C *decl.ClassDecl) []*decl.ClassDecl {
+	node, ok := h.lookup(C)
+	if !ok {
+		return nil
+	}
+	return h.classesOf(h.children[node])
+}
+
+// SubClasses returns all classes below C, directly or indirectly, without the classes which are equivalent to C.
+func (h *Hierarchy) SubClasses(C *decl.ClassDecl) []*decl.ClassDecl {
+	node, ok := h.lookup(C)
+	if !ok {
+		return nil
+	}
+	return h.classesOf(closure(h.children, node))
+}
+
+// EquivalentClasses returns the classes which are equivalent to C, without C itself.
+func (h *Hierarchy) EquivalentClasses(C *decl.ClassDecl) []*decl.ClassDecl {
+	node, ok := h.lookup(C)
+	if !ok {
+		return nil
+	}
+	var res []*decl.ClassDecl
+	for _, D := range h.classesOf([]int{node}) {
+		if D.IRI != C.IRI {
+			res = append(res, D)
+		}
+	}
+	return res
+}
+
+// IsSubClassOf is true if C is below or equivalent to D.
+func (h *Hierarchy) IsSubClassOf(C, D *decl.ClassDecl) bool {
+	c, ok := h.lookup(C)
+	if !ok {
+		return false
+	}
+	d, ok := h.lookup(D)
+	if !ok {
+		return false
+	}
+	if c == d {
+		return true
+	}
+	for _, a := range closure(h.parents, c) {
+		if a == d {
+			return true
+		}
+	}
+	return false
+}
+
+// Roots returns the classes without superclasses, i.e. directly below owl:Thing.
+func (h *Hierarchy) Roots() []*decl.ClassDecl {
+	var nodes []int
+	for node, ps := range h.parents {
+		if len(ps) == 0 {
+			nodes = append(nodes, node)
+		}
+	}
+	return h.classesOf(nodes)
+}
+
+// Leaves returns the classes without subclasses.
+func (h *Hierarchy) Leaves() []*decl.ClassDecl {
+	var nodes []int
+	for node, cs := range h.children {
+		if len(cs) == 0 {
+			nodes = append(nodes, node)
+		}
+	}
+	return h.classesOf(nodes)
+}
+
+// Cycles returns the equivalence sets which come from cycles of SubClassOf axioms, like SubClassOf(A B) and SubClassOf(B A).
+// Such cycles are allowed in OWL, but often unintended. Equivalences from EquivalentClasses axioms alone are no cycles,
+// and neither is a SubClassOf axiom between classes of the same EquivalentClasses axiom.
+func (h *Hierarchy) Cycles() [][]*decl.ClassDecl {
+	var res [][]*decl.ClassDecl
+	for node, cyclic := range h.cyclic {
+		if cyclic {
+			res = append(res, h.classesOf([]int{node}))
+		}
+	}
+	return res
+}
+
+// LowestCommonAncestors returns the most specific classes which are above or equivalent to both C and D.
+// If C is a subclass of D, this is D and its equivalent classes. There can be several lowest common ancestors,
+// because a class can have several superclasses. The result is empty if owl:Thing is the only common ancestor.
+func (h *Hierarchy) LowestCommonAncestors(C, D *decl.ClassDecl) []*decl.ClassDecl {
+	c, ok := h.lookup(C)
+	if !ok {
+		return nil
+	}
+	d, ok := h.lookup(D)
+	if !ok {
+		return nil
+	}
+	common := map[int]bool{}
+	for _, a := range append(closure(h.parents, c), c) {
+		common[a] = true
+	}
+	var candidates []int
+	for _, a := range append(closure(h.parents, d), d) {
+		if common[a] {
+			candidates = append(candidates, a)
+		}
+	}
+	// keep the candidates which are not above another candidate
+	above := map[int]bool{}
+	for _, a := range candidates {
+		for _, p := range closure(h.parents, a) {
+			above[p] = true
+		}
+	}
+	var nodes []int
+	for _, a := range candidates {
+		if !above[a] {
+			nodes = append(nodes, a)
+		}
+	}
+	return h.classesOf(nodes)
+}
@@ -0,0 +1,101 @@
+package hierarchy_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/hierarchy"
+)
+
+const ontology = `Prefix(:=<urn:test#>)
+Ontology(<urn:test>
+	Declaration(Class(:Food))
+	SubClassOf(:Pizza :Food)
+	SubClassOf(:Pasta :Food)
+	SubClassOf(:Margherita :Pizza)
+	SubClassOf(:Margherita :Food)
+	EquivalentClasses(:Pizza :Flatbread)
+	SubClassOf(:Lasagne ObjectIntersectionOf(:Pasta :Baked ObjectSomeValuesFrom(:hasTopping :Cheese)))
+	EquivalentClasses(:CheesyPizza ObjectIntersectionOf(:Pizza ObjectSomeValuesFrom(:hasTopping :Cheese)))
+	DisjointUnion(:Topping :Cheese :Tomato)
+	SubClassOf(:Chicken :Egg)
+	SubClassOf(:Egg :Chicken)
+)`
+
+func iris(Cs []*decl.ClassDecl) string {
+	var res []string
+	for _, C := range Cs {
+		res = append(res, strings.TrimPrefix(C.IRI, "urn:test#"))
+	}
+	return strings.Join(res, " ")
+}
+
+func TestHierarchy(t *testing.T) {
+	o, err := gofp.OntologyFromReader(strings.NewReader(ontology), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := hierarchy.New(o.K)
+	class := func(name string) *decl.ClassDecl {
+		C, ok := o.K.ClassDecl("urn:test#" + name)
+		if !ok {
+			t.Fatal(name)
+		}
+		return C.(*decl.ClassDecl)
+	}
+
+	for _, c := range []struct {
+		name, got, need string
+	}{
+		{"DirectSuperClasses Margherita", iris(h.DirectSuperClasses(class("Margherita"))), "Pizza Flatbread"},
+		{"SuperClasses Margherita", iris(h.SuperClasses(class("Margherita"))), "Food Pizza Flatbread"},
+		{"DirectSubClasses Food", iris(h.DirectSubClasses(class("Food"))), "Pizza Pasta Flatbread"},
+		{"SubClasses Food", iris(h.SubClasses(class("Food"))), "Pizza Pasta Margherita Flatbread Lasagne CheesyPizza"},
+		{"DirectSuperClasses Lasagne", iris(h.DirectSuperClasses(class("Lasagne"))), "Pasta Baked"},
+		{"DirectSuperClasses CheesyPizza", iris(h.DirectSuperClasses(class("CheesyPizza"))), "Pizza Flatbread"},
+		{"DirectSuperClasses Tomato", iris(h.DirectSuperClasses(class("Tomato"))), "Topping"},
+		{"EquivalentClasses Pizza", iris(h.EquivalentClasses(class("Pizza"))), "Flatbread"},
+		{"EquivalentClasses Food", iris(h.EquivalentClasses(class("Food"))), ""},
+		{"Roots", iris(h.Roots()), "Food Baked Topping Chicken Egg"},
+		{"Leaves", iris(h.Leaves()), "Margherita Lasagne Cheese CheesyPizza Tomato Chicken Egg"},
+		{"LowestCommonAncestors Margherita Lasagne", iris(h.LowestCommonAncestors(class("Margherita"), class("Lasagne"))), "Food"},
+		{"LowestCommonAncestors Margherita CheesyPizza", iris(h.LowestCommonAncestors(class("Margherita"), class("CheesyPizza"))), "Pizza Flatbread"},
+		{"LowestCommonAncestors Margherita Pizza", iris(h.LowestCommonAncestors(class("Margherita"), class("Pizza"))), "Pizza Flatbread"},
+		{"LowestCommonAncestors Margherita Cheese", iris(h.LowestCommonAncestors(class("Margherita"), class("Cheese"))), ""},
+	} {
+		if c.got != c.need {
+			t.Errorf("%v: got %q, need %q", c.name, c.got, c.need)
+		}
+	}
+
+	if cycles := h.Cycles(); len(cycles) != 1 || iris(cycles[0]) != "Chicken Egg" {
+		t.Fatal(cycles)
+	}
+	if !h.IsSubClassOf(class("Margherita"), class("Flatbread")) || h.IsSubClassOf(class("Food"), class("Pizza")) || !h.IsSubClassOf(class("Egg"), class("Chicken")) {
+		t.Fatal("IsSubClassOf")
+	}
+	if h.SuperClasses(&decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:unknown"}}) != nil {
+		t.Fatal("unknown class")
+	}
+}
+
+func TestCyclesWithEquivalentClasses(t *testing.T) {
+	o, err := gofp.OntologyFromReader(strings.NewReader(`Prefix(:=<urn:test#>)
+Ontology(<urn:test>
+	EquivalentClasses(:Pizza :Flatbread)
+	SubClassOf(:Pizza :Flatbread)
+	EquivalentClasses(:Chicken :Hen)
+	SubClassOf(:Hen :Egg)
+	SubClassOf(:Egg :Chicken)
+)`), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := hierarchy.New(o.K)
+	// the redundant SubClassOf(:Pizza :Flatbread) is no cycle, but the SubClassOf axioms via :Hen and :Chicken are
+	if cycles := h.Cycles(); len(cycles) != 1 || iris(cycles[0]) != "Chicken Hen Egg" {
+		t.Fatal(cycles)
+	}
+}
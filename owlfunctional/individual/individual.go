@@ -1,5 +1,45 @@
 package individual
 
+import (
+	"github.com/shful/gofp/owlfunctional/decl"
+)
+
+// Individual is either a named individual, or an anonymous individual like _:a1.
+// Named individuals are identified by Name, which is the full IRI. Anonymous individuals are identified by NodeID together with
+// DocumentID, since a node ID is local to the ontology document where it appears.
 type Individual struct {
 	Name string
+
+	// Decl is the declaration of a named individual, and nil for anonymous individuals.
+	Decl *decl.NamedIndividualDecl
+
+	// NodeID is the node ID of an anonymous individual, including the leading "_:".
+	// It is empty for named individuals.
+	NodeID string
+
+	// DocumentID is the parser.Parser.DocumentID of the document which contained NodeID.
+	// It is 0 for named individuals.
+	DocumentID int64
+}
+
+// NewNamed returns the named individual with the given declaration.
+func NewNamed(d *decl.NamedIndividualDecl) Individual {
+	return Individual{Name: d.IRI, Decl: d}
+}
+
+// NewAnonymous returns the anonymous individual with the given node ID, found in the document with documentID.
+func NewAnonymous(nodeID string, documentID int64) Individual {
+	return Individual{NodeID: nodeID, DocumentID: documentID}
+}
+
+// IsAnonymous is true for an anonymous individual, and false for a named individual.
+func (s Individual) IsAnonymous() bool {
+	return s.NodeID != ""
+}
+
+func (s Individual) String() string {
+	if s.IsAnonymous() {
+		return s.NodeID
+	}
+	return s.Name
 }
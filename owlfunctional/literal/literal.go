@@ -2,6 +2,7 @@ package literal
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/shful/gofp/owlfunctional/parser"
 )
@@ -30,6 +31,36 @@ func (s *OWLLiteral) LiteralString() string {
 	return res
 }
 
+// ParseLiteralString is the reverse of LiteralString.
+// ok is false if value does not start with a quoted string.
+func ParseLiteralString(value string) (l OWLLiteral, ok bool) {
+	if !strings.HasPrefix(value, `"`) {
+		return
+	}
+	end := 1
+	for ; end < len(value); end++ {
+		if value[end] == '\\' {
+			end++ // skip the escaped character
+			continue
+		}
+		if value[end] == '"' {
+			break
+		}
+	}
+	if end >= len(value) {
+		l.Value = value[1:]
+		return l, true
+	}
+	l.Value = value[1:end]
+	rest := value[end+1:]
+	if i := strings.Index(rest, "^^"); i >= 0 {
+		l.Literaltype = rest[i+2:]
+		rest = rest[:i]
+	}
+	l.LangTag = strings.TrimPrefix(rest, "@")
+	return l, true
+}
+
 // MaybeOWLLiteral is true when this token can be a valid literal expression.
 func MaybeOWLLiteral(tok parser.Token) bool {
 	switch tok {
@@ -0,0 +1,230 @@
+package manchester
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/assertions"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Axiom renders a single axiom, as stored by the default stores, e.g. "Margherita SubClassOf Pizza".
+// axiom is a value of one of the types in the packages axioms, assertions or annotations.
+// For the object property characteristics, which are stored as property expressions only, see ObjectPropertyCharacteristic.
+func (r *Renderer) Axiom(axiom interface{}) (res string, err error) {
+	switch x := axiom.(type) {
+	case axioms.DatatypeDefinition:
+		return r.DataRange(x.DN) + " EquivalentTo " + r.DataRange(x.D), nil
+
+	// Class expression axioms
+	case axioms.SubClassOf:
+		return r.ClassExpression(x.C1) + " SubClassOf " + r.ClassExpression(x.C2), nil
+	case axioms.EquivalentClasses:
+		return r.nary("EquivalentClasses", " EquivalentTo ", r.classExpressionList(x.EquivalentClasses)), nil
+	case axioms.DisjointClasses:
+		return r.nary("DisjointClasses", " DisjointWith ", r.classExpressionList(x.DisjointClasses)), nil
+	case axioms.DisjointUnion:
+		return r.ClassExpression(x.CN) + " DisjointUnionOf " + strings.Join(r.classExpressionList(x.DisjointClasses), ", "), nil
+
+	// Object property axioms
+	case axioms.SubObjectPropertyOf:
+		return r.ObjectPropertyExpression(x.P1) + " SubPropertyOf " + r.ObjectPropertyExpression(x.P2), nil
+	case axioms.SubObjectPropertyChainOf:
+		chain := make([]string, len(x.Chain))
+		for i, P := range x.Chain {
+			chain[i] = r.ObjectPropertyExpression(P)
+		}
+		return strings.Join(chain, " o ") + " SubPropertyOf " + r.ObjectPropertyExpression(x.P), nil
+	case axioms.EquivalentObjectProperties:
+		return r.nary("EquivalentProperties", " EquivalentTo ", r.objectPropertyExpressionList(x.Ps)), nil
+	case axioms.DisjointObjectProperties:
+		return r.nary("DisjointProperties", " DisjointWith ", r.objectPropertyExpressionList(x.Ps)), nil
+	case axioms.InverseObjectProperties:
+		return r.ObjectPropertyExpression(x.P1) + " InverseOf " + r.ObjectPropertyExpression(x.P2), nil
+	case axioms.ObjectPropertyDomain:
+		return r.ObjectPropertyExpression(x.P) + " Domain " + r.ClassExpression(x.C), nil
+	case axioms.ObjectPropertyRange:
+		return r.ObjectPropertyExpression(x.P) + " Range " + r.ClassExpression(x.C), nil
+
+	// Data property axioms
+	case axioms.SubDataPropertyOf:
+		return r.DataProperty(x.P1) + " SubPropertyOf " + r.DataProperty(x.P2), nil
+	case axioms.EquivalentDataProperties:
+		return r.nary("EquivalentProperties", " EquivalentTo ", r.dataPropertyList(x.Rs)), nil
+	case axioms.DisjointDataProperties:
+		return r.nary("DisjointProperties", " DisjointWith ", r.dataPropertyList(x.Rs)), nil
+	case axioms.DataPropertyDomain:
+		return r.DataProperty(x.R) + " Domain " + r.ClassExpression(x.C), nil
+	case axioms.DataPropertyRange:
+		return r.DataProperty(x.R) + " Range " + r.DataRange(x.D), nil
+
+	// Keys
+	case axioms.HasKey:
+		keys := append(r.objectPropertyExpressionList(x.Ps), r.dataPropertyList(x.Rs)...)
+		return r.ClassExpression(x.C) + " HasKey " + strings.Join(keys, ", "), nil
+
+	// Assertions
+	case axioms.SameIndividual:
+		return r.nary("SameIndividual", " SameAs ", r.individualList(x.As)), nil
+	case axioms.DifferentIndividuals:
+		return r.nary("DifferentIndividuals", " DifferentFrom ", r.individualList(x.As)), nil
+	case axioms.ClassAssertion:
+		return r.Individual(x.A) + " Type " + r.ClassExpression(x.C), nil
+	case assertions.ObjectPropertyAssertion:
+		return r.Individual(x.A1) + " " + r.ObjectPropertyExpression(x.P) + " " + r.Individual(x.A2), nil
+	case assertions.NegativeObjectPropertyAssertion:
+		return "not (" + r.Individual(x.A1) + " " + r.ObjectPropertyExpression(x.P) + " " + r.Individual(x.A2) + ")", nil
+	case axioms.DataPropertyAssertion:
+		return r.Individual(x.A) + " " + r.DataProperty(x.R) + " " + r.Literal(x.V), nil
+	case assertions.NegativeDataPropertyAssertion:
+		return "not (" + r.Individual(x.A) + " " + r.DataProperty(x.R) + " " + r.Literal(x.V) + ")", nil
+
+	// Annotation axioms
+	case annotations.AnnotationAssertion:
+		return r.annotationValue(x.S) + " " + r.AnnotationProperty(x.A) + " " + r.annotationValue(x.T), nil
+	case annotations.SubAnnotationPropertyOf:
+		return r.AnnotationProperty(x.A1) + " SubPropertyOf " + r.AnnotationProperty(x.A2), nil
+	case annotations.AnnotationPropertyDomain:
+		return r.AnnotationProperty(x.A) + " Domain " + r.IRI(x.U), nil
+	case annotations.AnnotationPropertyRange:
+		return r.AnnotationProperty(x.A) + " Range " + r.IRI(x.U), nil
+	}
+	return "", fmt.Errorf("cannot render axiom of type %T", axiom)
+}
+
+// ObjectPropertyCharacteristic renders the characteristic of P, which is one of
+// "Functional", "InverseFunctional", "Reflexive", "Irreflexive", "Symmetric", "Asymmetric" or "Transitive",
+// e.g. "Functional: hasBase".
+func (r *Renderer) ObjectPropertyCharacteristic(characteristic string, P meta.ObjectPropertyExpression) string {
+	return characteristic + ": " + r.ObjectPropertyExpression(P)
+}
+
+// Axioms renders all axioms from k, one string per axiom.
+// The axioms are grouped by type, in the same order as with the OWL-Functional writer.
+func (r *Renderer) Axioms(k storedefaults.K) (res []string, err error) {
+	add := func(axiom interface{}) {
+		if err != nil {
+			return
+		}
+		var line string
+		if line, err = r.Axiom(axiom); err == nil {
+			res = append(res, line)
+		}
+	}
+	characteristics := func(characteristic string, Ps []meta.ObjectPropertyExpression) {
+		for _, P := range Ps {
+			res = append(res, r.ObjectPropertyCharacteristic(characteristic, P))
+		}
+	}
+
+	for _, x := range k.AllDatatypeDefinitions() {
+		add(x)
+	}
+	for _, x := range k.AllSubClassOfs() {
+		add(x)
+	}
+	for _, x := range k.AllEquivalentClasses() {
+		add(x)
+	}
+	for _, x := range k.AllDisjointClasses() {
+		add(x)
+	}
+	for _, x := range k.AllDisjointUnions() {
+		add(x)
+	}
+	for _, x := range k.AllSubObjectPropertyOfs() {
+		add(x)
+	}
+	for _, x := range k.AllSubObjectPropertyChainOfs() {
+		add(x)
+	}
+	for _, x := range k.AllEquivalentObjectProperties() {
+		add(x)
+	}
+	for _, x := range k.AllDisjointObjectProperties() {
+		add(x)
+	}
+	for _, x := range k.AllInverseObjectProperties() {
+		add(x)
+	}
+	for _, x := range k.AllObjectPropertyDomains() {
+		add(x)
+	}
+	for _, x := range k.AllObjectPropertyRanges() {
+		add(x)
+	}
+	characteristics("Functional", k.AllFunctionalObjectProperties())
+	characteristics("InverseFunctional", k.AllInverseFunctionalObjectProperties())
+	characteristics("Reflexive", k.AllReflexiveObjectProperties())
+	characteristics("Irreflexive", k.AllIrreflexiveObjectProperties())
+	characteristics("Symmetric", k.AllSymmetricObjectProperties())
+	characteristics("Asymmetric", k.AllAsymmetricObjectProperties())
+	characteristics("Transitive", k.AllTransitiveObjectProperties())
+	for _, x := range k.AllSubDataPropertyOfs() {
+		add(x)
+	}
+	for _, x := range k.AllEquivalentDataProperties() {
+		add(x)
+	}
+	for _, x := range k.AllDisjointDataProperties() {
+		add(x)
+	}
+	for _, x := range k.AllDataPropertyDomains() {
+		add(x)
+	}
+	for _, x := range k.AllDataPropertyRanges() {
+		add(x)
+	}
+	for _, R := range k.AllFunctionalDataProperties() {
+		res = append(res, "Functional: "+r.DataProperty(R))
+	}
+	for _, x := range k.AllHasKeys() {
+		add(x)
+	}
+	for _, x := range k.AllSameIndividuals() {
+		add(x)
+	}
+	for _, x := range k.AllDifferentIndividuals() {
+		add(x)
+	}
+	for _, x := range k.AllClassAssertions() {
+		add(x)
+	}
+	for _, x := range k.AllObjectPropertyAssertions() {
+		add(x)
+	}
+	for _, x := range k.AllNegativeObjectPropertyAssertions() {
+		add(x)
+	}
+	for _, x := range k.AllDataPropertyAssertions() {
+		add(x)
+	}
+	for _, x := range k.AllNegativeDataPropertyAssertions() {
+		add(x)
+	}
+	for _, x := range k.AllAnnotationAssertions() {
+		add(x)
+	}
+	for _, x := range k.AllSubAnnotationPropertyOfs() {
+		add(x)
+	}
+	for _, x := range k.AllAnnotationPropertyDomains() {
+		add(x)
+	}
+	for _, x := range k.AllAnnotationPropertyRanges() {
+		add(x)
+	}
+	return
+}
+
+// nary renders an n-ary axiom. Two operands are joined with the infix keyword, as in "A DisjointWith B".
+// More operands are listed after the keyword, as in "DisjointClasses: A, B, C".
+func (r *Renderer) nary(keyword, infix string, operands []string) string {
+	if len(operands) == 2 {
+		return operands[0] + infix + operands[1]
+	}
+	return keyword + ": " + strings.Join(operands, ", ")
+}
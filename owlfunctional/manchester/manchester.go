@@ -0,0 +1,410 @@
+// manchester renders class expressions and axioms in the OWL 2 Manchester Syntax
+// (https://www.w3.org/TR/owl2-manchester-syntax/), e.g. "Pizza and hasTopping some Cheese".
+// This is meant for showing ontology content to people. Axioms are rendered one per line,
+// in the style of ontology editors, e.g. "Margherita SubClassOf Pizza", and not as frames.
+package manchester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/properties"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Renderer renders OWL entities and expressions in Manchester Syntax.
+// IRIs are shortened with the prefixes. The default prefix "" is left out, as in Manchester Syntax.
+type Renderer struct {
+	prefixes    map[string]string
+	prefixNames []string // sorted keys of prefixes
+
+	// labels are the names to show instead of IRIs, by full IRI
+	labels map[string]string
+}
+
+// NewRenderer returns a Renderer which shortens IRIs with the given prefixes, e.g. Ontology.Prefixes.
+func NewRenderer(prefixes map[string]string) *Renderer {
+	r := &Renderer{prefixes: prefixes}
+	for name := range prefixes {
+		r.prefixNames = append(r.prefixNames, name)
+	}
+	sort.Strings(r.prefixNames)
+	return r
+}
+
+// NewRendererWithLabels returns a Renderer which shows entities by their rdfs:label, as found in k.AllAnnotationAssertions().
+// Labels with the language tag lang are preferred, then labels without language tag, then any label.
+// Entities without label are shown by their shortened IRI, as with NewRenderer.
+func NewRendererWithLabels(prefixes map[string]string, k storedefaults.K, lang string) *Renderer {
+	r := NewRenderer(prefixes)
+	r.labels = map[string]string{}
+	rank := map[string]int{} // rank of the label in labels; lower is better
+	for _, x := range k.AllAnnotationAssertions() {
+		A, ok := x.A.(*decl.AnnotationPropertyDecl)
+		if !ok || A.IRI != builtindatatypes.PRE_RDFS+"label" {
+			continue
+		}
+		l, ok := literal.ParseLiteralString(x.T)
+		if !ok {
+			continue
+		}
+		var labelRank int
+		switch l.LangTag {
+		case lang:
+			labelRank = 0
+		case "":
+			labelRank = 1
+		default:
+			labelRank = 2
+		}
+		if old, ok := rank[x.S]; ok && old <= labelRank {
+			continue
+		}
+		r.labels[x.S] = unescape(l.Value)
+		rank[x.S] = labelRank
+	}
+	return r
+}
+
+// IRI renders an entity with the full IRI iri, as label, prefixed name or full IRI in <>.
+// A label with a single quote cannot be quoted in Manchester Syntax, so that the entity is rendered without its label then.
+func (r *Renderer) IRI(iri string) string {
+	if label, ok := r.labels[iri]; ok {
+		if isSimpleName(label) {
+			return label
+		}
+		if !strings.Contains(label, "'") {
+			return "'" + label + "'"
+		}
+	}
+
+	var best, bestHead string
+	var found bool
+	for _, name := range r.prefixNames {
+		head := r.prefixes[name]
+		if !strings.HasPrefix(iri, head) || (found && len(head) <= len(bestHead)) {
+			continue
+		}
+		if isSimpleName(iri[len(head):]) {
+			best, bestHead, found = name, head, true
+		}
+	}
+	if !found {
+		return "<" + iri + ">"
+	}
+	if best == "" {
+		return iri[len(bestHead):]
+	}
+	return best + ":" + iri[len(bestHead):]
+}
+
+// ClassExpression renders C, e.g. "Pizza and hasTopping some Cheese".
+// Parentheses are set where the precedence of Manchester Syntax needs them ("not" binds strongest, then restrictions, "and", "or").
+func (r *Renderer) ClassExpression(C meta.ClassExpression) string {
+	switch C := C.(type) {
+	case *decl.ClassDecl:
+		return r.IRI(C.IRI)
+	case *classexpression.OWLThing:
+		return r.IRI(builtindatatypes.PRE_OWL + "Thing")
+	case *classexpression.OWLNothing:
+		return r.IRI(builtindatatypes.PRE_OWL + "Nothing")
+	case *classexpression.ObjectIntersectionOf:
+		parts := make([]string, len(C.Cs))
+		for i, C := range C.Cs {
+			parts[i] = r.primary(C)
+		}
+		return strings.Join(parts, " and ")
+	case *classexpression.ObjectUnionOf:
+		parts := make([]string, len(C.Cs))
+		for i, C := range C.Cs {
+			if _, ok := C.(*classexpression.ObjectUnionOf); ok {
+				parts[i] = "(" + r.ClassExpression(C) + ")"
+			} else {
+				parts[i] = r.ClassExpression(C)
+			}
+		}
+		return strings.Join(parts, " or ")
+	case *classexpression.ObjectComplementOf:
+		if _, ok := C.C.(*classexpression.ObjectComplementOf); ok {
+			return "not (" + r.ClassExpression(C.C) + ")"
+		}
+		return "not " + r.primary(C.C)
+	case *classexpression.ObjectOneOf:
+		return "{" + strings.Join(r.individualList(C.As), ", ") + "}"
+	case *classexpression.ObjectSomeValuesFrom:
+		return r.ObjectPropertyExpression(C.P) + " some " + r.primary(C.C)
+	case *classexpression.ObjectAllValuesFrom:
+		return r.ObjectPropertyExpression(C.P) + " only " + r.primary(C.C)
+	case *classexpression.ObjectHasValue:
+		return r.ObjectPropertyExpression(C.P) + " value " + r.Individual(C.A)
+	case *classexpression.ObjectHasSelf:
+		return r.ObjectPropertyExpression(C.P) + " Self"
+	case *classexpression.ObjectMinCardinality:
+		return fmt.Sprintf("%v min %v", r.ObjectPropertyExpression(C.P), C.N)
+	case *classexpression.ObjectMaxCardinality:
+		return fmt.Sprintf("%v max %v", r.ObjectPropertyExpression(C.P), C.N)
+	case *classexpression.ObjectExactCardinality:
+		return fmt.Sprintf("%v exactly %v", r.ObjectPropertyExpression(C.P), C.N)
+	case *classexpression.ObjectQualifiedMinCardinality:
+		return fmt.Sprintf("%v min %v %v", r.ObjectPropertyExpression(C.P), C.N, r.primary(C.C))
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		return fmt.Sprintf("%v max %v %v", r.ObjectPropertyExpression(C.P), C.N, r.primary(C.C))
+	case *classexpression.ObjectQualifiedExactCardinality:
+		return fmt.Sprintf("%v exactly %v %v", r.ObjectPropertyExpression(C.P), C.N, r.primary(C.C))
+	case *classexpression.DataSomeValuesFrom:
+		return r.DataProperty(C.R) + " some " + r.dataPrimary(C.D)
+	case *classexpression.DataAllValuesFrom:
+		return r.DataProperty(C.R) + " only " + r.dataPrimary(C.D)
+	case *classexpression.DataHasValue:
+		return r.DataProperty(C.R) + " value " + r.Literal(C.V)
+	case *classexpression.DataMinCardinality:
+		return fmt.Sprintf("%v min %v", r.DataProperty(C.R), C.N)
+	case *classexpression.DataMaxCardinality:
+		return fmt.Sprintf("%v max %v", r.DataProperty(C.R), C.N)
+	case *classexpression.DataExactCardinality:
+		return fmt.Sprintf("%v exactly %v", r.DataProperty(C.R), C.N)
+	case *classexpression.DataQualifiedMinCardinality:
+		return fmt.Sprintf("%v min %v %v", r.DataProperty(C.R), C.N, r.dataPrimary(C.D))
+	case *classexpression.DataQualifiedMaxCardinality:
+		return fmt.Sprintf("%v max %v %v", r.DataProperty(C.R), C.N, r.dataPrimary(C.D))
+	case *classexpression.DataQualifiedExactCardinality:
+		return fmt.Sprintf("%v exactly %v %v", r.DataProperty(C.R), C.N, r.dataPrimary(C.D))
+	}
+	return fmt.Sprintf("<unknown %T>", C)
+}
+
+// primary renders C, in parentheses if C is an intersection or union.
+func (r *Renderer) primary(C meta.ClassExpression) string {
+	switch C.(type) {
+	case *classexpression.ObjectIntersectionOf, *classexpression.ObjectUnionOf:
+		return "(" + r.ClassExpression(C) + ")"
+	}
+	return r.ClassExpression(C)
+}
+
+func (r *Renderer) classExpressionList(Cs []meta.ClassExpression) []string {
+	res := make([]string, len(Cs))
+	for i, C := range Cs {
+		res[i] = r.ClassExpression(C)
+	}
+	return res
+}
+
+// ObjectPropertyExpression renders P, e.g. "hasTopping" or "inverse hasTopping".
+func (r *Renderer) ObjectPropertyExpression(P meta.ObjectPropertyExpression) string {
+	switch P := P.(type) {
+	case *decl.ObjectPropertyDecl:
+		return r.IRI(P.IRI)
+	case *properties.ObjectInverseOf:
+		return "inverse " + r.IRI(P.PN)
+	case *properties.OWLTopObjectProperty:
+		return r.IRI(builtindatatypes.PRE_OWL + "topObjectProperty")
+	case *properties.OWLBottomObjectProperty:
+		return r.IRI(builtindatatypes.PRE_OWL + "bottomObjectProperty")
+	}
+	return fmt.Sprintf("<unknown %T>", P)
+}
+
+func (r *Renderer) objectPropertyExpressionList(Ps []meta.ObjectPropertyExpression) []string {
+	res := make([]string, len(Ps))
+	for i, P := range Ps {
+		res[i] = r.ObjectPropertyExpression(P)
+	}
+	return res
+}
+
+func (r *Renderer) DataProperty(R meta.DataProperty) string {
+	switch R := R.(type) {
+	case *decl.DataPropertyDecl:
+		return r.IRI(R.IRI)
+	case *properties.OWLTopDataProperty:
+		return r.IRI(builtindatatypes.PRE_OWL + "topDataProperty")
+	case *properties.OWLBottomDataProperty:
+		return r.IRI(builtindatatypes.PRE_OWL + "bottomDataProperty")
+	}
+	return fmt.Sprintf("<unknown %T>", R)
+}
+
+func (r *Renderer) dataPropertyList(Rs []meta.DataProperty) []string {
+	res := make([]string, len(Rs))
+	for i, R := range Rs {
+		res[i] = r.DataProperty(R)
+	}
+	return res
+}
+
+// AnnotationProperty renders A, which is a declaration or, as in SubAnnotationPropertyOf, the IRI.
+func (r *Renderer) AnnotationProperty(A meta.AnnotationProperty) string {
+	switch A := A.(type) {
+	case *decl.AnnotationPropertyDecl:
+		return r.IRI(A.IRI)
+	case string:
+		return r.IRI(A)
+	}
+	return fmt.Sprintf("<unknown %T>", A)
+}
+
+// DataRange renders D, e.g. "xsd:integer[>= 0]".
+func (r *Renderer) DataRange(D meta.DataRange) string {
+	switch D := D.(type) {
+	case *facets.BuiltinDatatype:
+		return r.IRI(D.DatatypeIRI)
+	case *facets.CustomNamedDatatype:
+		return r.IRI(D.DatatypeIRI)
+	case *decl.DatatypeDecl:
+		return r.IRI(D.IRI)
+	case *facets.DatatypeRestriction:
+		parts := make([]string, len(D.FVPairs))
+		for i, fv := range D.FVPairs {
+			parts[i] = facet(fv.F) + " " + r.Literal(fv.V)
+		}
+		return r.DataRange(D.DN) + "[" + strings.Join(parts, ", ") + "]"
+	case *dataranges.DataComplementOf:
+		if _, ok := D.D.(*dataranges.DataComplementOf); ok {
+			return "not (" + r.DataRange(D.D) + ")"
+		}
+		return "not " + r.dataPrimary(D.D)
+	case *dataranges.DataIntersectionOf:
+		parts := make([]string, len(D.Ds))
+		for i, D := range D.Ds {
+			parts[i] = r.dataPrimary(D)
+		}
+		return strings.Join(parts, " and ")
+	case *dataranges.DataUnionOf:
+		parts := make([]string, len(D.Ds))
+		for i, D := range D.Ds {
+			if _, ok := D.(*dataranges.DataUnionOf); ok {
+				parts[i] = "(" + r.DataRange(D) + ")"
+			} else {
+				parts[i] = r.DataRange(D)
+			}
+		}
+		return strings.Join(parts, " or ")
+	case *dataranges.DataOneOf:
+		parts := make([]string, len(D.Vs))
+		for i, v := range D.Vs {
+			parts[i] = r.Literal(v)
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	}
+	return fmt.Sprintf("<unknown %T>", D)
+}
+
+// dataPrimary renders D, in parentheses if D is an intersection or union.
+func (r *Renderer) dataPrimary(D meta.DataRange) string {
+	switch D.(type) {
+	case *dataranges.DataIntersectionOf, *dataranges.DataUnionOf:
+		return "(" + r.DataRange(D) + ")"
+	}
+	return r.DataRange(D)
+}
+
+// facet returns the Manchester Syntax for f, e.g. ">=" for xsd:minInclusive.
+func facet(f facets.Facet) string {
+	switch f {
+	case facets.Xsd_minInclusive:
+		return ">="
+	case facets.Xsd_maxInclusive:
+		return "<="
+	case facets.Xsd_minExclusive:
+		return ">"
+	case facets.Xsd_maxExclusive:
+		return "<"
+	case facets.Rdf_langRange:
+		return "langRange"
+	}
+	return strings.TrimPrefix(f.IRI(), builtindatatypes.PRE_XSD)
+}
+
+// Individual renders a named individual by IRI, and an anonymous individual by node ID.
+func (r *Renderer) Individual(a individual.Individual) string {
+	if a.IsAnonymous() {
+		return a.NodeID
+	}
+	return r.IRI(a.Name)
+}
+
+func (r *Renderer) individualList(as []individual.Individual) []string {
+	res := make([]string, len(as))
+	for i, a := range as {
+		res[i] = r.Individual(a)
+	}
+	return res
+}
+
+// Literal renders l. Strings are written without datatype, and integers without quotes.
+func (r *Renderer) Literal(l literal.OWLLiteral) string {
+	if l.LangTag != "" {
+		return `"` + l.Value + `"@` + l.LangTag
+	}
+	switch l.Literaltype {
+	case "", builtindatatypes.PRE_XSD + "string":
+		return `"` + l.Value + `"`
+	case builtindatatypes.PRE_XSD + "integer":
+		if isInteger(l.Value) {
+			return l.Value
+		}
+	}
+	return `"` + l.Value + `"^^` + r.IRI(l.Literaltype)
+}
+
+// annotationValue renders the subject or target of an annotation, which is an IRI, a node ID or a literal.
+func (r *Renderer) annotationValue(value string) string {
+	if l, ok := literal.ParseLiteralString(value); ok {
+		return r.Literal(l)
+	}
+	if strings.HasPrefix(value, "_:") {
+		return value
+	}
+	return r.IRI(value)
+}
+
+// isSimpleName is true if name can be written without quotes or brackets.
+func isSimpleName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, ch := range name {
+		if i == 0 && !unicode.IsLetter(ch) && ch != '_' {
+			return false
+		}
+		if !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' && ch != '-' {
+			return false
+		}
+	}
+	return !keywords[name]
+}
+
+// keywords cannot be used as simple names.
+var keywords = map[string]bool{
+	"and": true, "or": true, "not": true, "some": true, "only": true, "value": true, "Self": true,
+	"min": true, "max": true, "exactly": true, "inverse": true, "that": true,
+}
+
+func isInteger(value string) bool {
+	value = strings.TrimLeft(value, "+-")
+	if value == "" {
+		return false
+	}
+	for _, ch := range value {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// unescape removes the OWL escapes \" and \\ from a literal value.
+func unescape(value string) string {
+	return strings.NewReplacer(`\"`, `"`, `\\`, `\`).Replace(value)
+}
@@ -0,0 +1,117 @@
+package manchester
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp/mock/mockontology"
+)
+
+func TestAxioms(t *testing.T) {
+	o := mockontology.Pizza(t)
+	lines, err := NewRenderer(o.Prefixes).Axioms(o.K)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"calories EquivalentTo xsd:integer[>= 0]",
+		"Margherita SubClassOf Pizza and hasTopping some Tomato",
+		"Pizza SubClassOf hasBase min 1 PizzaBase",
+		"Pizza SubClassOf hasBase max 3",
+		"Pizza SubClassOf inverse isBaseOf exactly 1",
+		"Pizza SubClassOf Thin or Thick and Crispy",
+		"Pizza SubClassOf hasCalories only calories",
+		"Pizza SubClassOf hasCalories exactly 1 xsd:integer",
+		"Pizza SubClassOf inverse isBaseOf only owl:Thing",
+		"Pizza SubClassOf hasBase Self",
+		"Vegan SubClassOf hasTopping only (Vegetable or not (not Meat))",
+		"Light SubClassOf hasCalories some xsd:integer[>= 0, < 400]",
+		"EquivalentClasses: Spicy, Chili or not Mild, {Diavolo, _:x1}",
+		"Light EquivalentTo hasCalories some ({100, 200} or not xsd:integer)",
+		"Diavolos EquivalentTo {Diavolo, Devil}",
+		"DisjointClasses: Tomato, Chili, Salami",
+		"Topping DisjointUnionOf Tomato, Chili",
+		"hasBase SubPropertyOf hasIngredient",
+		"hasBase o hasIngredient SubPropertyOf hasIngredient",
+		"hasTopping EquivalentTo hasCover",
+		"hasTopping DisjointWith hasBase",
+		"hasBase InverseOf isBaseOf",
+		"hasTopping Domain Pizza",
+		"hasTopping Range Topping",
+		"Functional: hasBase",
+		"Transitive: hasIngredient",
+		"hasCalories SubPropertyOf hasNutrition",
+		"hasCalories Domain Pizza",
+		"hasName Range xsd:string and xsd:string",
+		"Functional: hasCalories",
+		"Pizza HasKey hasBase, hasName",
+		"Diavolo SameAs Devil",
+		"Diavolo DifferentFrom Margherita1",
+		"Diavolo Type Pizza",
+		"Diavolo Type hasTopping value Chili",
+		"Diavolo hasTopping _:x1",
+		"_:x1 inverse isToppingOf Diavolo",
+		"not (Margherita1 hasTopping Salami)",
+		"Diavolo hasCalories 300",
+		`Diavolo hasName "Teufel"@de`,
+		`not (Diavolo hasName "Angel")`,
+		`Pizza rdfs:comment "a \"round\" dish"`,
+		"Pizza rdfs:seeAlso <http://en.wikipedia.org/wiki/Pizza>",
+		`Margherita rdfs:label "Pizza Margherita"@en`,
+		`Margherita rdfs:label "Margherita"@it`,
+		`hasTopping rdfs:label "topping"`,
+		"note SubPropertyOf rdfs:comment",
+		"rdfs:comment Domain Pizza",
+	}
+	if strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("got:\n%v\nexpected:\n%v", strings.Join(lines, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestLabels(t *testing.T) {
+	o := mockontology.Pizza(t)
+	r := NewRendererWithLabels(o.Prefixes, o.K, "en")
+	res := r.ClassExpression(o.K.AllSubClassOfs()[0].C2)
+	if res != "Pizza and topping some Tomato" {
+		t.Fatal(res)
+	}
+	if res := r.ClassExpression(o.K.AllSubClassOfs()[0].C1); res != "'Pizza Margherita'" {
+		t.Fatal(res)
+	}
+	r = NewRendererWithLabels(o.Prefixes, o.K, "it")
+	if res := r.ClassExpression(o.K.AllSubClassOfs()[0].C1); res != "Margherita" {
+		t.Fatal(res)
+	}
+
+	// a label with a quote is not used
+	o = mockontology.Parse(t, `Prefix(:=<http://example.com/pizza#>)
+Prefix(rdfs:=<http://www.w3.org/2000/01/rdf-schema#>)
+Ontology(
+	SubClassOf(:QuattroStagioni :Pizza)
+	AnnotationAssertion(rdfs:label :QuattroStagioni "Pizza 'Quattro Stagioni'")
+)`)
+	r = NewRendererWithLabels(o.Prefixes, o.K, "en")
+	if res := r.ClassExpression(o.K.AllSubClassOfs()[0].C1); res != "QuattroStagioni" {
+		t.Fatal(res)
+	}
+}
+
+func TestIRI(t *testing.T) {
+	r := NewRenderer(map[string]string{"": "http://example.com/a#", "ex": "http://example.com/"})
+	for iri, expected := range map[string]string{
+		"http://example.com/a#Pizza": "Pizza",
+		"http://example.com/Pizza":   "ex:Pizza",
+		"http://example.com/a#some":  "<http://example.com/a#some>",
+		"urn:other":                  "<urn:other>",
+	} {
+		if r.IRI(iri) != expected {
+			t.Fatal(iri, r.IRI(iri))
+		}
+	}
+}
+
+func TestUnknownAxiom(t *testing.T) {
+	if _, err := NewRenderer(nil).Axiom(42); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -6,6 +6,9 @@ type AnnotationProperty interface {
 type Annotation interface {
 	A() AnnotationProperty
 	T() string
+
+	// DocumentID is the document of T if T is the node ID of an anonymous individual, and 0 otherwise.
+	DocumentID() int64
 }
 
 // ClassExpression is one of: a named class,
@@ -0,0 +1,366 @@
+// normalize transforms class expressions into equivalent, simpler forms, like the negation normal form.
+// The results are new expression trees; the original expressions are never modified.
+// Named classes, properties, individuals, literals and data ranges are shared between the original and the result.
+package normalize
+
+import (
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/structural"
+)
+
+// Normalize returns the simplified negation normal form of C, i.e. Simplify(NNF(C)).
+func Normalize(C meta.ClassExpression) meta.ClassExpression {
+	return Simplify(NNF(C))
+}
+
+// NNF returns the negation normal form of C, where ObjectComplementOf is pushed inwards,
+// until it applies to a named class, ObjectOneOf or ObjectHasSelf only.
+// For example, ObjectComplementOf(ObjectSomeValuesFrom(P A)) becomes ObjectAllValuesFrom(P ObjectComplementOf(A)).
+// Negated cardinality restrictions become cardinality restrictions, e.g. the complement of ObjectMinCardinality(2 P)
+// is ObjectMaxCardinality(1 P). Negated exact cardinalities and data restrictions are handled likewise.
+func NNF(C meta.ClassExpression) meta.ClassExpression {
+	switch C := C.(type) {
+	case *classexpression.ObjectComplementOf:
+		return negate(C.C)
+	case *classexpression.ObjectIntersectionOf:
+		return &classexpression.ObjectIntersectionOf{Cs: nnfs(C.Cs)}
+	case *classexpression.ObjectUnionOf:
+		return &classexpression.ObjectUnionOf{Cs: nnfs(C.Cs)}
+	case *classexpression.ObjectSomeValuesFrom:
+		return &classexpression.ObjectSomeValuesFrom{P: C.P, C: NNF(C.C)}
+	case *classexpression.ObjectAllValuesFrom:
+		return &classexpression.ObjectAllValuesFrom{P: C.P, C: NNF(C.C)}
+	case *classexpression.ObjectQualifiedMinCardinality:
+		return &classexpression.ObjectQualifiedMinCardinality{N: C.N, P: C.P, C: NNF(C.C)}
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		return &classexpression.ObjectQualifiedMaxCardinality{N: C.N, P: C.P, C: NNF(C.C)}
+	case *classexpression.ObjectQualifiedExactCardinality:
+		return &classexpression.ObjectQualifiedExactCardinality{N: C.N, P: C.P, C: NNF(C.C)}
+	}
+	return shallowCopy(C)
+}
+
+// negate returns the negation normal form of ObjectComplementOf(C).
+func negate(C meta.ClassExpression) meta.ClassExpression {
+	switch C := C.(type) {
+	case *classexpression.OWLThing:
+		return &classexpression.OWLNothing{}
+	case *classexpression.OWLNothing:
+		return &classexpression.OWLThing{}
+	case *classexpression.ObjectComplementOf:
+		return NNF(C.C)
+	case *classexpression.ObjectIntersectionOf:
+		return &classexpression.ObjectUnionOf{Cs: negates(C.Cs)}
+	case *classexpression.ObjectUnionOf:
+		return &classexpression.ObjectIntersectionOf{Cs: negates(C.Cs)}
+	case *classexpression.ObjectSomeValuesFrom:
+		return &classexpression.ObjectAllValuesFrom{P: C.P, C: negate(C.C)}
+	case *classexpression.ObjectAllValuesFrom:
+		return &classexpression.ObjectSomeValuesFrom{P: C.P, C: negate(C.C)}
+	case *classexpression.ObjectHasValue:
+		return &classexpression.ObjectAllValuesFrom{P: C.P, C: &classexpression.ObjectComplementOf{
+			C: &classexpression.ObjectOneOf{As: []individual.Individual{C.A}},
+		}}
+	case *classexpression.ObjectMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLNothing{}
+		}
+		return &classexpression.ObjectMaxCardinality{N: C.N - 1, P: C.P}
+	case *classexpression.ObjectMaxCardinality:
+		return &classexpression.ObjectMinCardinality{N: C.N + 1, P: C.P}
+	case *classexpression.ObjectExactCardinality:
+		if C.N == 0 {
+			return &classexpression.ObjectMinCardinality{N: 1, P: C.P}
+		}
+		return &classexpression.ObjectUnionOf{Cs: []meta.ClassExpression{
+			&classexpression.ObjectMaxCardinality{N: C.N - 1, P: C.P},
+			&classexpression.ObjectMinCardinality{N: C.N + 1, P: C.P},
+		}}
+	case *classexpression.ObjectQualifiedMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLNothing{}
+		}
+		return &classexpression.ObjectQualifiedMaxCardinality{N: C.N - 1, P: C.P, C: NNF(C.C)}
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		return &classexpression.ObjectQualifiedMinCardinality{N: C.N + 1, P: C.P, C: NNF(C.C)}
+	case *classexpression.ObjectQualifiedExactCardinality:
+		if C.N == 0 {
+			return &classexpression.ObjectQualifiedMinCardinality{N: 1, P: C.P, C: NNF(C.C)}
+		}
+		return &classexpression.ObjectUnionOf{Cs: []meta.ClassExpression{
+			&classexpression.ObjectQualifiedMaxCardinality{N: C.N - 1, P: C.P, C: NNF(C.C)},
+			&classexpression.ObjectQualifiedMinCardinality{N: C.N + 1, P: C.P, C: NNF(C.C)},
+		}}
+	case *classexpression.DataSomeValuesFrom:
+		return &classexpression.DataAllValuesFrom{R: C.R, D: complementOf(C.D)}
+	case *classexpression.DataAllValuesFrom:
+		return &classexpression.DataSomeValuesFrom{R: C.R, D: complementOf(C.D)}
+	case *classexpression.DataHasValue:
+		return &classexpression.DataAllValuesFrom{R: C.R, D: &dataranges.DataComplementOf{
+			D: &dataranges.DataOneOf{Vs: []literal.OWLLiteral{C.V}},
+		}}
+	case *classexpression.DataMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLNothing{}
+		}
+		return &classexpression.DataMaxCardinality{N: C.N - 1, R: C.R}
+	case *classexpression.DataMaxCardinality:
+		return &classexpression.DataMinCardinality{N: C.N + 1, R: C.R}
+	case *classexpression.DataExactCardinality:
+		if C.N == 0 {
+			return &classexpression.DataMinCardinality{N: 1, R: C.R}
+		}
+		return &classexpression.ObjectUnionOf{Cs: []meta.ClassExpression{
+			&classexpression.DataMaxCardinality{N: C.N - 1, R: C.R},
+			&classexpression.DataMinCardinality{N: C.N + 1, R: C.R},
+		}}
+	case *classexpression.DataQualifiedMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLNothing{}
+		}
+		return &classexpression.DataQualifiedMaxCardinality{N: C.N - 1, R: C.R, D: C.D}
+	case *classexpression.DataQualifiedMaxCardinality:
+		return &classexpression.DataQualifiedMinCardinality{N: C.N + 1, R: C.R, D: C.D}
+	case *classexpression.DataQualifiedExactCardinality:
+		if C.N == 0 {
+			return &classexpression.DataQualifiedMinCardinality{N: 1, R: C.R, D: C.D}
+		}
+		return &classexpression.ObjectUnionOf{Cs: []meta.ClassExpression{
+			&classexpression.DataQualifiedMaxCardinality{N: C.N - 1, R: C.R, D: C.D},
+			&classexpression.DataQualifiedMinCardinality{N: C.N + 1, R: C.R, D: C.D},
+		}}
+	}
+	// named classes, ObjectOneOf, ObjectHasSelf and unknown types
+	return &classexpression.ObjectComplementOf{C: shallowCopy(C)}
+}
+
+// complementOf returns DataComplementOf(D), without a double complement.
+func complementOf(D meta.DataRange) meta.DataRange {
+	if D, ok := D.(*dataranges.DataComplementOf); ok {
+		return D.D
+	}
+	return &dataranges.DataComplementOf{D: D}
+}
+
+// Simplify returns C with the following rewritings, applied to all nested class expressions:
+//
+// - nested ObjectIntersectionOf and ObjectUnionOf are flattened, and duplicate operands are removed (see package structural),
+// - owl:Thing operands of intersections and owl:Nothing operands of unions are dropped,
+// - an intersection with owl:Nothing is owl:Nothing, a union with owl:Thing is owl:Thing,
+// - intersections and unions with a single operand are replaced by that operand,
+// - a double ObjectComplementOf is removed,
+// - exact cardinalities become the intersection of the min and max cardinality,
+// - ObjectSomeValuesFrom(P owl:Nothing) becomes owl:Nothing, ObjectAllValuesFrom(P owl:Thing) becomes owl:Thing,
+// - min cardinalities of 0 become owl:Thing.
+//
+// Simplify does not push negations inwards, see NNF and Normalize.
+func Simplify(C meta.ClassExpression) meta.ClassExpression {
+	switch C := C.(type) {
+	case *classexpression.ObjectComplementOf:
+		switch inner := Simplify(C.C).(type) {
+		case *classexpression.ObjectComplementOf:
+			return inner.C
+		case *classexpression.OWLThing:
+			return &classexpression.OWLNothing{}
+		case *classexpression.OWLNothing:
+			return &classexpression.OWLThing{}
+		default:
+			return &classexpression.ObjectComplementOf{C: inner}
+		}
+	case *classexpression.ObjectIntersectionOf:
+		return simplifyIntersection(C.Cs)
+	case *classexpression.ObjectUnionOf:
+		return simplifyUnion(C.Cs)
+	case *classexpression.ObjectSomeValuesFrom:
+		filler := Simplify(C.C)
+		if isNothing(filler) {
+			return &classexpression.OWLNothing{}
+		}
+		return &classexpression.ObjectSomeValuesFrom{P: C.P, C: filler}
+	case *classexpression.ObjectAllValuesFrom:
+		filler := Simplify(C.C)
+		if isThing(filler) {
+			return &classexpression.OWLThing{}
+		}
+		return &classexpression.ObjectAllValuesFrom{P: C.P, C: filler}
+	case *classexpression.ObjectMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLThing{}
+		}
+	case *classexpression.ObjectExactCardinality:
+		return simplifyIntersection([]meta.ClassExpression{
+			&classexpression.ObjectMinCardinality{N: C.N, P: C.P},
+			&classexpression.ObjectMaxCardinality{N: C.N, P: C.P},
+		})
+	case *classexpression.ObjectQualifiedMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLThing{}
+		}
+		return &classexpression.ObjectQualifiedMinCardinality{N: C.N, P: C.P, C: Simplify(C.C)}
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		return &classexpression.ObjectQualifiedMaxCardinality{N: C.N, P: C.P, C: Simplify(C.C)}
+	case *classexpression.ObjectQualifiedExactCardinality:
+		filler := Simplify(C.C)
+		return simplifyIntersection([]meta.ClassExpression{
+			&classexpression.ObjectQualifiedMinCardinality{N: C.N, P: C.P, C: filler},
+			&classexpression.ObjectQualifiedMaxCardinality{N: C.N, P: C.P, C: filler},
+		})
+	case *classexpression.DataMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLThing{}
+		}
+	case *classexpression.DataQualifiedMinCardinality:
+		if C.N == 0 {
+			return &classexpression.OWLThing{}
+		}
+	case *classexpression.DataExactCardinality:
+		return simplifyIntersection([]meta.ClassExpression{
+			&classexpression.DataMinCardinality{N: C.N, R: C.R},
+			&classexpression.DataMaxCardinality{N: C.N, R: C.R},
+		})
+	case *classexpression.DataQualifiedExactCardinality:
+		return simplifyIntersection([]meta.ClassExpression{
+			&classexpression.DataQualifiedMinCardinality{N: C.N, R: C.R, D: C.D},
+			&classexpression.DataQualifiedMaxCardinality{N: C.N, R: C.R, D: C.D},
+		})
+	}
+	return shallowCopy(C)
+}
+
+func simplifyIntersection(Cs []meta.ClassExpression) meta.ClassExpression {
+	var res []meta.ClassExpression
+	for _, C := range Cs {
+		C = Simplify(C)
+		switch C := C.(type) {
+		case *classexpression.OWLThing:
+			continue
+		case *classexpression.OWLNothing:
+			return C
+		case *classexpression.ObjectIntersectionOf:
+			// already simplified, so that its operands are no intersections
+			res = append(res, C.Cs...)
+		default:
+			res = append(res, C)
+		}
+	}
+	res = structural.Dedup(res)
+	switch len(res) {
+	case 0:
+		return &classexpression.OWLThing{}
+	case 1:
+		return res[0]
+	}
+	return &classexpression.ObjectIntersectionOf{Cs: res}
+}
+
+func simplifyUnion(Cs []meta.ClassExpression) meta.ClassExpression {
+	var res []meta.ClassExpression
+	for _, C := range Cs {
+		C = Simplify(C)
+		switch C := C.(type) {
+		case *classexpression.OWLNothing:
+			continue
+		case *classexpression.OWLThing:
+			return C
+		case *classexpression.ObjectUnionOf:
+			res = append(res, C.Cs...)
+		default:
+			res = append(res, C)
+		}
+	}
+	res = structural.Dedup(res)
+	switch len(res) {
+	case 0:
+		return &classexpression.OWLNothing{}
+	case 1:
+		return res[0]
+	}
+	return &classexpression.ObjectUnionOf{Cs: res}
+}
+
+func isThing(C meta.ClassExpression) bool {
+	_, ok := C.(*classexpression.OWLThing)
+	return ok
+}
+
+func isNothing(C meta.ClassExpression) bool {
+	_, ok := C.(*classexpression.OWLNothing)
+	return ok
+}
+
+func nnfs(Cs []meta.ClassExpression) []meta.ClassExpression {
+	res := make([]meta.ClassExpression, len(Cs))
+	for i, C := range Cs {
+		res[i] = NNF(C)
+	}
+	return res
+}
+
+func negates(Cs []meta.ClassExpression) []meta.ClassExpression {
+	res := make([]meta.ClassExpression, len(Cs))
+	for i, C := range Cs {
+		res[i] = negate(C)
+	}
+	return res
+}
+
+// shallowCopy returns a copy of C, which must have no nested class expressions,
+// so that the result of a transformation never shares a class expression node with the original, except named classes.
+func shallowCopy(C meta.ClassExpression) meta.ClassExpression {
+	switch C := C.(type) {
+	case *classexpression.OWLThing:
+		return &classexpression.OWLThing{}
+	case *classexpression.OWLNothing:
+		return &classexpression.OWLNothing{}
+	case *classexpression.ObjectOneOf:
+		return &classexpression.ObjectOneOf{As: append([]individual.Individual(nil), C.As...)}
+	case *classexpression.ObjectHasValue:
+		c := *C
+		return &c
+	case *classexpression.ObjectHasSelf:
+		c := *C
+		return &c
+	case *classexpression.ObjectMinCardinality:
+		c := *C
+		return &c
+	case *classexpression.ObjectMaxCardinality:
+		c := *C
+		return &c
+	case *classexpression.ObjectExactCardinality:
+		c := *C
+		return &c
+	case *classexpression.DataSomeValuesFrom:
+		c := *C
+		return &c
+	case *classexpression.DataAllValuesFrom:
+		c := *C
+		return &c
+	case *classexpression.DataHasValue:
+		c := *C
+		return &c
+	case *classexpression.DataMinCardinality:
+		c := *C
+		return &c
+	case *classexpression.DataMaxCardinality:
+		c := *C
+		return &c
+	case *classexpression.DataExactCardinality:
+		c := *C
+		return &c
+	case *classexpression.DataQualifiedMinCardinality:
+		c := *C
+		return &c
+	case *classexpression.DataQualifiedMaxCardinality:
+		c := *C
+		return &c
+	case *classexpression.DataQualifiedExactCardinality:
+		c := *C
+		return &c
+	}
+	// named classes and unknown types
+	return C
+}
@@ -0,0 +1,97 @@
+package normalize_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/normalize"
+	"github.com/shful/gofp/owlfunctional/structural"
+)
+
+// assertPairs parses SubClassOf axioms, and checks that f transforms each subclass into the superclass.
+func assertPairs(t *testing.T, f func(meta.ClassExpression) meta.ClassExpression, axioms string) {
+	o, err := gofp.OntologyFromReader(strings.NewReader(`Prefix(:=<urn:test#>)
+Prefix(owl:=<http://www.w3.org/2002/07/owl#>)
+Prefix(xsd:=<http://www.w3.org/2001/XMLSchema#>)
+Ontology(<urn:test>
+`+axioms+`
+)`), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs := o.K.AllSubClassOfs()
+	if len(subs) != strings.Count(axioms, "SubClassOf") {
+		t.Fatal(len(subs))
+	}
+	for _, sub := range subs {
+		original := structural.Key(sub.C1)
+		res := f(sub.C1)
+		if !structural.Equal(res, sub.C2) {
+			t.Errorf("%v:\n got  %v\n need %v", original, structural.Key(res), structural.Key(sub.C2))
+		}
+		if structural.Key(sub.C1) != original {
+			t.Errorf("modified %v", original)
+		}
+	}
+}
+
+func TestNNF(t *testing.T) {
+	assertPairs(t, normalize.NNF, `
+	SubClassOf(ObjectComplementOf(ObjectComplementOf(:A)) :A)
+	SubClassOf(ObjectComplementOf(ObjectIntersectionOf(:A ObjectUnionOf(:B :C))) ObjectUnionOf(ObjectComplementOf(:A) ObjectIntersectionOf(ObjectComplementOf(:B) ObjectComplementOf(:C))))
+	SubClassOf(ObjectComplementOf(ObjectSomeValuesFrom(:p ObjectAllValuesFrom(:q :A))) ObjectAllValuesFrom(:p ObjectSomeValuesFrom(:q ObjectComplementOf(:A))))
+	SubClassOf(ObjectComplementOf(ObjectMinCardinality(2 :p)) ObjectMaxCardinality(1 :p))
+	SubClassOf(ObjectComplementOf(ObjectMaxCardinality(2 :p :A)) ObjectMinCardinality(3 :p :A))
+	SubClassOf(ObjectComplementOf(ObjectExactCardinality(2 :p)) ObjectUnionOf(ObjectMaxCardinality(1 :p) ObjectMinCardinality(3 :p)))
+	SubClassOf(ObjectComplementOf(ObjectHasValue(:p :x)) ObjectAllValuesFrom(:p ObjectComplementOf(ObjectOneOf(:x))))
+	SubClassOf(ObjectComplementOf(DataSomeValuesFrom(:d DataComplementOf(xsd:integer))) DataAllValuesFrom(:d xsd:integer))
+	SubClassOf(ObjectComplementOf(owl:Thing) owl:Nothing)
+	SubClassOf(ObjectSomeValuesFrom(:p ObjectComplementOf(ObjectUnionOf(:A :B))) ObjectSomeValuesFrom(:p ObjectIntersectionOf(ObjectComplementOf(:A) ObjectComplementOf(:B))))
+	SubClassOf(ObjectComplementOf(ObjectHasSelf(:p)) ObjectComplementOf(ObjectHasSelf(:p)))
+	`)
+}
+
+func TestSimplify(t *testing.T) {
+	assertPairs(t, normalize.Simplify, `
+	SubClassOf(ObjectIntersectionOf(:A ObjectIntersectionOf(:B owl:Thing) :A) ObjectIntersectionOf(:A :B))
+	SubClassOf(ObjectUnionOf(:A owl:Nothing ObjectUnionOf(:B ObjectUnionOf(:C :A))) ObjectUnionOf(:A :B :C))
+	SubClassOf(ObjectIntersectionOf(:A owl:Nothing) owl:Nothing)
+	SubClassOf(ObjectUnionOf(:A ObjectIntersectionOf(owl:Thing owl:Thing)) owl:Thing)
+	SubClassOf(ObjectIntersectionOf(owl:Thing :B) :B)
+	SubClassOf(ObjectComplementOf(ObjectComplementOf(:A)) :A)
+	SubClassOf(ObjectExactCardinality(2 :p) ObjectIntersectionOf(ObjectMinCardinality(2 :p) ObjectMaxCardinality(2 :p)))
+	SubClassOf(ObjectExactCardinality(1 :p :A) ObjectIntersectionOf(ObjectMinCardinality(1 :p :A) ObjectMaxCardinality(1 :p :A)))
+	SubClassOf(ObjectSomeValuesFrom(:p ObjectIntersectionOf(:A owl:Nothing)) owl:Nothing)
+	SubClassOf(ObjectAllValuesFrom(:p ObjectUnionOf(:A owl:Thing)) owl:Thing)
+	SubClassOf(ObjectIntersectionOf(:A ObjectMinCardinality(0 :p)) :A)
+	SubClassOf(ObjectComplementOf(ObjectUnionOf(:A :B)) ObjectComplementOf(ObjectUnionOf(:A :B)))
+	`)
+}
+
+func TestNormalize(t *testing.T) {
+	assertPairs(t, normalize.Normalize, `
+	SubClassOf(ObjectComplementOf(ObjectUnionOf(:A ObjectComplementOf(ObjectIntersectionOf(:B :C)))) ObjectIntersectionOf(ObjectComplementOf(:A) :B :C))
+	SubClassOf(ObjectComplementOf(ObjectIntersectionOf(:A ObjectComplementOf(owl:Nothing))) ObjectComplementOf(:A))
+	SubClassOf(ObjectComplementOf(ObjectMinCardinality(0 :p)) owl:Nothing)
+	`)
+}
+
+func TestNNFDoesNotShare(t *testing.T) {
+	self := &classexpression.ObjectHasSelf{}
+	oneOf := &classexpression.ObjectOneOf{As: []individual.Individual{individual.NewAnonymous("_:x", 1)}}
+	for _, C := range []meta.ClassExpression{self, oneOf} {
+		res := normalize.NNF(&classexpression.ObjectComplementOf{C: C})
+		if res.(*classexpression.ObjectComplementOf).C == C {
+			t.Fatalf("result shares %v with the original", structural.Key(C))
+		}
+	}
+	res := normalize.NNF(&classexpression.ObjectComplementOf{C: oneOf})
+	res.(*classexpression.ObjectComplementOf).C.(*classexpression.ObjectOneOf).As[0].NodeID = "_:y"
+	if oneOf.As[0].NodeID != "_:x" {
+		t.Fatal(oneOf.As)
+	}
+}
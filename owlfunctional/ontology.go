@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/facets"
 	"github.com/shful/gofp/owlfunctional/individual"
 	"github.com/shful/gofp/owlfunctional/literal"
 	"github.com/shful/gofp/owlfunctional/meta"
@@ -33,6 +34,15 @@ type Ontology struct {
 	Prefixes       map[string]string
 	allAnnotations []annotations.Annotation
 
+	// Imports are the IRIs of all directly imported ontologies, as given by the Import(...) statements.
+	// The IRIs are fully resolved, i.e. without prefix and without surrounding <>.
+	Imports []string
+
+	// Imported are the directly imported ontologies which were loaded for this ontology, in the order of Imports.
+	// An import which was loaded before, e.g. by another imported ontology, is not repeated here, so Imported can be shorter than Imports.
+	// Imported remains empty, unless imports were resolved (see gofp.OntologyFromReaderWithImports).
+	Imported []*Ontology
+
 	// K is a convenience attribute  which gives read access to all parsed Knowledge
 	// Note that K references the default container types from the storedefaults package.
 	// When parsing into custom structures instead, K must remain unset.
@@ -71,8 +81,20 @@ func NewOntology(
 }
 
 // Parse consumes "Ontology(...)" with both enclosing braces.
+// In recovery mode (see parser.Parser.SetRecovery), an erroneous axiom is skipped, and parsing continues with the next one.
+// Then, all errors are returned together as parser.PErrs.
+// If the parser stopped early (see parser.Parser.Err), that error is returned instead, even in recovery mode.
 func (s *Ontology) Parse(p *parser.Parser) (err error) {
 	var initialPBal = p.PBal()
+	var errs parser.PErrs
+	var statements int
+	defer func() {
+		if perr := p.Err(); perr != nil {
+			err = perr
+		} else if err == nil && len(errs) > 0 {
+			err = errs
+		}
+	}()
 	var pos parser.ParserPosition
 	if err = p.ConsumeTokens(parser.Ontology, parser.B1); err != nil {
 		return pos.EnrichErrorMsg(err, "Parsing Ontology element:%v")
@@ -100,12 +122,17 @@ func (s *Ontology) Parse(p *parser.Parser) (err error) {
 		case parser.B2:
 			// must be the end of the Ontology() expression
 			if p.PBal() < initialPBal {
-				panic(pos.Errorf("internal: %v<%v", p.PBal(), initialPBal))
+				return pos.Errorf("internal: %v<%v", p.PBal(), initialPBal)
 			}
 			return
 		}
 		p.Unscan()
 
+		statements++
+		if maxAxioms := p.Limits().MaxAxioms; maxAxioms > 0 && statements > maxAxioms {
+			return pos.ErrorfKind(parser.ErrLimitExceeded, "more than %d axioms", maxAxioms)
+		}
+
 		switch tok {
 		case parser.Annotation:
 			err = s.parseOntologyAnnotation(p)
@@ -127,22 +154,40 @@ func (s *Ontology) Parse(p *parser.Parser) (err error) {
 			err = s.parseDataPropertyDomain(p)
 		case parser.DataPropertyRange:
 			err = s.parseDataPropertyRange(p)
+		case parser.DatatypeDefinition:
+			err = s.parseDatatypeDefinition(p)
 		case parser.DifferentIndividuals:
 			err = s.parseDifferentIndividuals(p)
 		case parser.DisjointClasses:
 			err = s.parseDisjointClasses(p)
+		case parser.DisjointDataProperties:
+			err = s.parseDisjointDataProperties(p)
+		case parser.DisjointObjectProperties:
+			err = s.parseDisjointObjectProperties(p)
+		case parser.DisjointUnion:
+			err = s.parseDisjointUnion(p)
 		case parser.EquivalentClasses:
 			err = s.parseEquivalentClasses(p)
+		case parser.EquivalentDataProperties:
+			err = s.parseEquivalentDataProperties(p)
+		case parser.EquivalentObjectProperties:
+			err = s.parseEquivalentObjectProperties(p)
 		case parser.FunctionalDataProperty:
 			err = s.parseFunctionalDataProperty(p)
 		case parser.FunctionalObjectProperty:
 			err = s.parseFunctionalObjectProperty(p)
+		case parser.HasKey:
+			err = s.parseHasKey(p)
+		case parser.Import:
+			err = s.parseImport(p)
 		case parser.InverseFunctionalObjectProperty:
 			err = s.parseInverseFunctionalObjectProperty(p)
 		case parser.InverseObjectProperties:
 			err = s.parseInverseObjectProperties(p)
 		case parser.IrreflexiveObjectProperty:
 			err = s.parseIrreflexiveObjectProperty(p)
+		case parser.NegativeDataPropertyAssertion:
+			err = s.parseNegativeDataPropertyAssertion(p)
 		case parser.NegativeObjectPropertyAssertion:
 			err = s.parseNegativeObjectPropertyAssertion(p)
 		case parser.ObjectPropertyAssertion:
@@ -153,6 +198,8 @@ func (s *Ontology) Parse(p *parser.Parser) (err error) {
 			err = s.parseObjectPropertyRange(p)
 		case parser.ReflexiveObjectProperty:
 			err = s.parseReflexiveObjectProperty(p)
+		case parser.SameIndividual:
+			err = s.parseSameIndividual(p)
 		case parser.SubAnnotationPropertyOf:
 			err = s.parseSubAnnotationPropertyOf(p)
 		case parser.SubClassOf:
@@ -162,15 +209,31 @@ func (s *Ontology) Parse(p *parser.Parser) (err error) {
 		case parser.SubObjectPropertyOf:
 			err = s.parseSubObjectPropertyOf(p)
 		case parser.SymmetricObjectProperty:
-			s.parseSymmetricObjectProperty(p)
+			err = s.parseSymmetricObjectProperty(p)
 		case parser.TransitiveObjectProperty:
 			err = s.parseTransitiveObjectProperty(p)
 		default:
-			err = pos.Errorf(`unexpected ontology token %v ("%v")`, parser.Tokenname(tok), lit)
+			err = pos.ErrorfKind(parser.ErrUnexpectedToken, `unexpected ontology token %v ("%v")`, parser.Tokenname(tok), lit)
 		}
 
 		if err != nil {
-			return
+			if !p.Recovery() {
+				return
+			}
+			errs = append(errs, pos.EnsurePErr(err))
+			if err = p.SkipTo(initialPBal + 1); err != nil {
+				errs = append(errs, pos.EnsurePErr(err))
+				return errs
+			}
+		}
+		if f, ok := s.AxiomStore.(store.Failer); ok {
+			if err = f.Err(); err != nil {
+				perr := pos.EnsurePErr(err)
+				if perr.Kind == parser.ErrOther {
+					perr.Kind = parser.ErrStore
+				}
+				return pos.EnrichErrorMsg(perr, "storing "+parser.Tokenname(tok))
+			}
 		}
 	}
 
@@ -188,6 +251,28 @@ func (s *Ontology) parseOntologyAnnotation(p *parser.Parser) (err error) {
 	return
 }
 
+// parseImport parses a single Import(...) statement, including braces,
+// and appends the IRI to the ontologies Imports. The imported ontology itself is not loaded here.
+func (s *Ontology) parseImport(p *parser.Parser) (err error) {
+	if err = p.ConsumeTokens(parser.Import, parser.B1); err != nil {
+		return
+	}
+	pos := p.Pos()
+
+	var ident *tech.IRI
+	ident, err = parsehelper.ParseAndResolveIRI(p, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "reading IRI in Import")
+		return
+	}
+
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	s.Imports = append(s.Imports, ident.String())
+	return
+}
+
 // parseAnnotationAssertion
 // - should not parse individuals into strings but maintain these individuals and reference them
 func (s *Ontology) parseAnnotationAssertion(p *parser.Parser) (err error) {
@@ -207,14 +292,16 @@ func (s *Ontology) parseAnnotationAssertion(p *parser.Parser) (err error) {
 	}
 
 	var s_ string
-	s_, _, err = parsefuncs.Parses(p, s.Decls, s)
+	var sType parsefuncs.ARGTYPE
+	s_, sType, err = parsefuncs.Parses(p, s.Decls, s)
 	if err != nil {
 		err = pos.EnrichErrorMsg(err, "reading 2nd param in AnnotationAssertion")
 		return
 	}
 	var t string
+	var tType parsefuncs.ARGTYPE
 
-	t, _, err = parsefuncs.Parset(p, s.Decls, s)
+	t, tType, err = parsefuncs.Parset(p, s.Decls, s)
 	if err != nil {
 		err = pos.EnrichErrorMsg(err, "reading 3rd param in AnnotationAssertion")
 		return
@@ -223,10 +310,17 @@ func (s *Ontology) parseAnnotationAssertion(p *parser.Parser) (err error) {
 	if err = p.ConsumeTokens(parser.B2); err != nil {
 		return
 	}
+
+	// node IDs are local to the document
+	var documentID int64
+	if sType == parsefuncs.ArgtypeAnonymousIndividual || tType == parsefuncs.ArgtypeAnonymousIndividual {
+		documentID = p.DocumentID()
+	}
 	s.AxiomStore.StoreAnnotationAssertion(
 		A,
 		s_,
 		t,
+		documentID,
 		anns,
 	)
 	return
@@ -299,10 +393,45 @@ func (s *Ontology) parseAnnotationPropertyRange(p *parser.Parser) (err error) {
 	return
 }
 
+// parseNegativeDataPropertyAssertion parses a single NegativeDataPropertyAssertion(...) expression, including braces.
+func (s *Ontology) parseNegativeDataPropertyAssertion(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.NegativeDataPropertyAssertion, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	pos := p.Pos()
+	var R meta.DataProperty
+	R, err = parsefuncs.ParseDataProperty(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "1st param in NegativeDataPropertyAssertion")
+		return
+	}
+	var a individual.Individual
+	a, err = parsefuncs.ParseIndividual(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "2nd param in NegativeDataPropertyAssertion")
+		return
+	}
+	var v literal.OWLLiteral
+	v, err = parsefuncs.ParseOWLLiteral(p, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "3rd param in NegativeDataPropertyAssertion")
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	s.AxiomStore.StoreNegativeDataPropertyAssertion(R, a, v, anns)
+	return
+}
+
 // parseNegativeObjectPropertyAssertion parses a single NegativeObjectPropertyAssertion(...) expression, including braces.
 func (s *Ontology) parseNegativeObjectPropertyAssertion(p *parser.Parser) (err error) {
-
-	if err = p.ConsumeTokens(parser.NegativeObjectPropertyAssertion, parser.B1); err != nil {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.NegativeObjectPropertyAssertion, p, s.Decls, s)
+	if err != nil {
 		return
 	}
 	pos := p.Pos()
@@ -310,19 +439,19 @@ func (s *Ontology) parseNegativeObjectPropertyAssertion(p *parser.Parser) (err e
 	var oe meta.ObjectPropertyExpression
 	oe, err = parsefuncs.ParseObjectPropertyExpression(p, s.Decls, s)
 	if err != nil {
-		err = pos.Errorf("parsing first param in NegativeObjectPropertyAssertion: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing first param in NegativeObjectPropertyAssertion")
 		return
 	}
 	var a1 individual.Individual
 	a1, err = parsefuncs.ParseIndividual(p, s.Decls, s)
 	if err != nil {
-		err = pos.Errorf("parsing first individual in NegativeObjectPropertyAssertion: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing first individual in NegativeObjectPropertyAssertion")
 		return
 	}
 	var a2 individual.Individual
 	a2, err = parsefuncs.ParseIndividual(p, s.Decls, s)
 	if err != nil {
-		err = pos.Errorf("parsing second individual in NegativeObjectPropertyAssertion: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing second individual in NegativeObjectPropertyAssertion")
 		return
 	}
 
@@ -330,34 +459,35 @@ func (s *Ontology) parseNegativeObjectPropertyAssertion(p *parser.Parser) (err e
 		return
 	}
 
-	s.AxiomStore.StoreNegativeObjectPropertyAssertion(oe, a1, a2)
+	s.AxiomStore.StoreNegativeObjectPropertyAssertion(oe, a1, a2, anns)
 	return
 }
 
 // parseObjectPropertyAssertion parses a single ObjectPropertyAssertion(...) expression, including braces.
 func (s *Ontology) parseObjectPropertyAssertion(p *parser.Parser) (err error) {
-
-	if err = p.ConsumeTokens(parser.ObjectPropertyAssertion, parser.B1); err != nil {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.ObjectPropertyAssertion, p, s.Decls, s)
+	if err != nil {
 		return
 	}
 	pos := p.Pos()
 
-	var ident *tech.IRI
-	ident, err = parsehelper.ParseAndResolveIRI(p, s)
+	var P meta.ObjectPropertyExpression
+	P, err = parsefuncs.ParseObjectPropertyExpression(p, s.Decls, s)
 	if err != nil {
-		err = pos.Errorf("parsing IRI in ObjectPropertyAssertion: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing first param in ObjectPropertyAssertion")
 		return
 	}
 	var a1 individual.Individual
 	a1, err = parsefuncs.ParseIndividual(p, s.Decls, s)
 	if err != nil {
-		err = pos.Errorf("parsing first individual in ObjectPropertyAssertion: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing first individual in ObjectPropertyAssertion")
 		return
 	}
 	var a2 individual.Individual
 	a2, err = parsefuncs.ParseIndividual(p, s.Decls, s)
 	if err != nil {
-		err = pos.Errorf("parsing second individual in ObjectPropertyAssertion: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing second individual in ObjectPropertyAssertion")
 		return
 	}
 
@@ -365,7 +495,7 @@ func (s *Ontology) parseObjectPropertyAssertion(p *parser.Parser) (err error) {
 		return
 	}
 
-	s.AxiomStore.StoreObjectPropertyAssertion(ident.String(), a1, a2)
+	s.AxiomStore.StoreObjectPropertyAssertion(P, a1, a2, anns)
 	return
 }
 
@@ -510,6 +640,32 @@ func (s *Ontology) parseDifferentIndividuals(p *parser.Parser) (err error) {
 	return
 }
 
+func (s *Ontology) parseSameIndividual(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.SameIndividual, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	var as []individual.Individual
+	pos := p.Pos()
+	as, err = parsefuncs.ParseIndividualsUntilB2(p, s.Decls, s)
+	if err != nil {
+		return
+	}
+	if len(as) < 2 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in SameIndividual, expected >=2", len(as))
+		return
+	}
+
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+
+	s.AxiomStore.StoreSameIndividual(as, anns)
+	return
+}
+
 func (s *Ontology) parseBracedIRI(p *parser.Parser) (ident *tech.IRI, err error) {
 	if err = p.ConsumeTokens(parser.B1); err != nil {
 		return
@@ -576,6 +732,40 @@ func (s *Ontology) parseDataPropertyRange(p *parser.Parser) (err error) {
 	return
 }
 
+// parseDatatypeDefinition parses a single DatatypeDefinition(...) expression, including braces.
+func (s *Ontology) parseDatatypeDefinition(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.DatatypeDefinition, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	pos := p.Pos()
+	var DN meta.NamedDatatype
+	DN, err = parsefuncs.ParseNamedDatatype(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "reading 1st param in DatatypeDefinition")
+		return
+	}
+	if _, ok := DN.(*facets.CustomNamedDatatype); !ok {
+		err = pos.ErrorfKind(parser.ErrInvalidIRI, "builtin datatype cannot be redefined in DatatypeDefinition")
+		return
+	}
+
+	var D meta.DataRange
+	D, err = parsefuncs.ParseDataRange(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "reading 2nd param in DatatypeDefinition")
+		return
+	}
+
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	s.AxiomStore.StoreDatatypeDefinition(DN, D, anns)
+	return
+}
+
 func (s *Ontology) parseDisjointClasses(p *parser.Parser) (err error) {
 	var anns []meta.Annotation
 	anns, err = parsefuncs.ParseAxiomBegin(parser.DisjointClasses, p, s.Decls, s)
@@ -590,7 +780,7 @@ func (s *Ontology) parseDisjointClasses(p *parser.Parser) (err error) {
 		return
 	}
 	if len(Cs) < 2 { //todo: is there a minimum ?
-		err = pos.Errorf("nt enough (%d) in DisjointClasses, expected >=2", len(Cs))
+		err = pos.ErrorfKind(parser.ErrParamCount, "nt enough (%d) in DisjointClasses, expected >=2", len(Cs))
 		return
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
@@ -600,6 +790,34 @@ func (s *Ontology) parseDisjointClasses(p *parser.Parser) (err error) {
 	return
 }
 
+func (s *Ontology) parseDisjointUnion(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.DisjointUnion, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	var Cs []meta.ClassExpression
+	pos := p.Pos()
+	Cs, err = parsefuncs.ParseClassExpressionsUntilB2(p, s.Decls, s)
+	if err != nil {
+		return
+	}
+	if len(Cs) < 3 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in DisjointUnion, expected a class and >=2 class expressions", len(Cs))
+		return
+	}
+	if !Cs[0].IsNamedClass() {
+		err = pos.Errorf("1st param in DisjointUnion must be a named class")
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	s.AxiomStore.StoreDisjointUnion(Cs[0], Cs[1:], anns)
+	return
+}
+
 func (s *Ontology) parseEquivalentClasses(p *parser.Parser) (err error) {
 	var anns []meta.Annotation
 	anns, err = parsefuncs.ParseAxiomBegin(parser.EquivalentClasses, p, s.Decls, s)
@@ -621,6 +839,70 @@ func (s *Ontology) parseEquivalentClasses(p *parser.Parser) (err error) {
 	return
 }
 
+func (s *Ontology) parseDisjointDataProperties(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.DisjointDataProperties, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	var Rs []meta.DataProperty
+	Rs, err = s.parseDataPropertiesUntilB2(p, "DisjointDataProperties")
+	if err != nil {
+		return
+	}
+	s.AxiomStore.StoreDisjointDataProperties(Rs, anns)
+	return
+}
+
+func (s *Ontology) parseDisjointObjectProperties(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.DisjointObjectProperties, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	var Ps []meta.ObjectPropertyExpression
+	Ps, err = s.parseObjectPropertyExpressionsUntilB2(p, "DisjointObjectProperties")
+	if err != nil {
+		return
+	}
+	s.AxiomStore.StoreDisjointObjectProperties(Ps, anns)
+	return
+}
+
+func (s *Ontology) parseEquivalentDataProperties(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.EquivalentDataProperties, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	var Rs []meta.DataProperty
+	Rs, err = s.parseDataPropertiesUntilB2(p, "EquivalentDataProperties")
+	if err != nil {
+		return
+	}
+	s.AxiomStore.StoreEquivalentDataProperties(Rs, anns)
+	return
+}
+
+func (s *Ontology) parseEquivalentObjectProperties(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.EquivalentObjectProperties, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	var Ps []meta.ObjectPropertyExpression
+	Ps, err = s.parseObjectPropertyExpressionsUntilB2(p, "EquivalentObjectProperties")
+	if err != nil {
+		return
+	}
+	s.AxiomStore.StoreEquivalentObjectProperties(Ps, anns)
+	return
+}
+
 func (s *Ontology) parseFunctionalDataProperty(p *parser.Parser) (err error) {
 	var anns []meta.Annotation
 	var R meta.DataProperty
@@ -657,6 +939,63 @@ func (s *Ontology) parseFunctionalObjectProperty(p *parser.Parser) (err error) {
 	return
 }
 
+// parseHasKey parses a single HasKey(...) expression, including braces.
+// Both the object property list and the data property list are enclosed in braces and may be empty.
+func (s *Ontology) parseHasKey(p *parser.Parser) (err error) {
+	var anns []meta.Annotation
+	anns, err = parsefuncs.ParseAxiomBegin(parser.HasKey, p, s.Decls, s)
+	if err != nil {
+		return
+	}
+
+	pos := p.Pos()
+	var C meta.ClassExpression
+	C, err = parsefuncs.ParseClassExpression(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "reading 1st param in HasKey")
+		return
+	}
+
+	var Ps []meta.ObjectPropertyExpression
+	if err = p.ConsumeTokens(parser.B1); err != nil {
+		err = pos.EnrichErrorMsg(err, "reading object properties in HasKey")
+		return
+	}
+	Ps, err = parsefuncs.ParseObjectPropertyExpressionsUntilB2(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "reading object properties in HasKey")
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+
+	var Rs []meta.DataProperty
+	if err = p.ConsumeTokens(parser.B1); err != nil {
+		err = pos.EnrichErrorMsg(err, "reading data properties in HasKey")
+		return
+	}
+	Rs, err = parsefuncs.ParseDataPropertiesUntilB2(p, s.Decls, s)
+	if err != nil {
+		err = pos.EnrichErrorMsg(err, "reading data properties in HasKey")
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+
+	if len(Ps)+len(Rs) == 0 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "HasKey needs at least one object or data property")
+		return
+	}
+
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	s.AxiomStore.StoreHasKey(C, Ps, Rs, anns)
+	return
+}
+
 func (s *Ontology) parseInverseFunctionalObjectProperty(p *parser.Parser) (err error) {
 	var anns []meta.Annotation
 	anns, err = parsefuncs.ParseAxiomBegin(parser.InverseFunctionalObjectProperty, p, s.Decls, s)
@@ -810,7 +1149,7 @@ func (s *Ontology) parseSubClassOf(p *parser.Parser) (err error) {
 		return
 	}
 	if len(Cs) != 2 {
-		err = pos.Errorf("wrong param count (%d) in SubClassOf, expected 2", len(Cs))
+		err = pos.ErrorfKind(parser.ErrParamCount, "wrong param count (%d) in SubClassOf, expected 2", len(Cs))
 		return
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
@@ -850,6 +1189,12 @@ func (s *Ontology) parseSubObjectPropertyOf(p *parser.Parser) (err error) {
 		return
 	}
 
+	tok, _, _ := p.ScanIgnoreWSAndComment()
+	p.Unscan()
+	if tok == parser.ObjectPropertyChain {
+		return s.parseSubObjectPropertyChainOf(p, anns)
+	}
+
 	var P1, P2 meta.ObjectPropertyExpression
 	if P1, err = parsefuncs.ParseObjectPropertyExpression(p, s.Decls, s); err != nil {
 		return
@@ -866,6 +1211,33 @@ func (s *Ontology) parseSubObjectPropertyOf(p *parser.Parser) (err error) {
 	return
 }
 
+// parseSubObjectPropertyChainOf parses the remaining "ObjectPropertyChain(P1 ... Pn) P)" of a SubObjectPropertyOf axiom,
+// including the closing brace.
+func (s *Ontology) parseSubObjectPropertyChainOf(p *parser.Parser, anns []meta.Annotation) (err error) {
+	pos := p.Pos()
+	if err = p.ConsumeTokens(parser.ObjectPropertyChain, parser.B1); err != nil {
+		return
+	}
+
+	var Chain []meta.ObjectPropertyExpression
+	Chain, err = s.parseObjectPropertyExpressionsUntilB2(p, "ObjectPropertyChain")
+	if err != nil {
+		return
+	}
+
+	var P meta.ObjectPropertyExpression
+	if P, err = parsefuncs.ParseObjectPropertyExpression(p, s.Decls, s); err != nil {
+		err = pos.EnrichErrorMsg(err, "reading super property in SubObjectPropertyOf")
+		return
+	}
+
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	s.AxiomStore.StoreSubObjectPropertyChainOf(Chain, P, anns)
+	return
+}
+
 func (s *Ontology) parseSymmetricObjectProperty(p *parser.Parser) (err error) {
 	var anns []meta.Annotation
 	anns, err = parsefuncs.ParseAxiomBegin(parser.SymmetricObjectProperty, p, s.Decls, s)
@@ -898,6 +1270,36 @@ func (s *Ontology) parseTransitiveObjectProperty(p *parser.Parser) (err error) {
 	return
 }
 
+// parseObjectPropertyExpressionsUntilB2 parses at least 2 ObjectPropertyExpressions and consumes the closing brace.
+// axiomName is for the error message only.
+func (s *Ontology) parseObjectPropertyExpressionsUntilB2(p *parser.Parser, axiomName string) (Ps []meta.ObjectPropertyExpression, err error) {
+	pos := p.Pos()
+	if Ps, err = parsefuncs.ParseObjectPropertyExpressionsUntilB2(p, s.Decls, s); err != nil {
+		return
+	}
+	if len(Ps) < 2 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in %v, expected >=2", len(Ps), axiomName)
+		return
+	}
+	err = p.ConsumeTokens(parser.B2)
+	return
+}
+
+// parseDataPropertiesUntilB2 parses at least 2 DataProperties and consumes the closing brace.
+// axiomName is for the error message only.
+func (s *Ontology) parseDataPropertiesUntilB2(p *parser.Parser, axiomName string) (Rs []meta.DataProperty, err error) {
+	pos := p.Pos()
+	if Rs, err = parsefuncs.ParseDataPropertiesUntilB2(p, s.Decls, s); err != nil {
+		return
+	}
+	if len(Rs) < 2 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in %v, expected >=2", len(Rs), axiomName)
+		return
+	}
+	err = p.ConsumeTokens(parser.B2)
+	return
+}
+
 // parseP parses the expression and consumes the closing brace.
 func (s *Ontology) parseP(p *parser.Parser) (P meta.ObjectPropertyExpression, err error) {
 
@@ -916,6 +1318,12 @@ func (s *Ontology) Annotations() []annotations.Annotation {
 	return s.allAnnotations
 }
 
+// AddAnnotation appends an Annotation given directly in the Ontology.
+// The parser does that for each "Annotation" statement; readers for other formats can do the same.
+func (s *Ontology) AddAnnotation(anno annotations.Annotation) {
+	s.allAnnotations = append(s.allAnnotations, anno)
+}
+
 func (s *Ontology) ResolvePrefix(prefix string) (res string, ok bool) {
 	res, ok = s.Prefixes[prefix]
 	return
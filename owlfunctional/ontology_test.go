@@ -81,7 +81,8 @@ func TestParseExplicitDeclMode(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if len(o.K.AllClassDecls()) != 6 {
+	// 5, not 6: BritishPizza was used before its explicit declaration, and must be counted once only
+	if len(o.K.AllClassDecls()) != 5 {
 		t.Fatal(o.K.AllClassDecls())
 	}
 
@@ -177,12 +178,15 @@ func TestParsePizzaOntology(t *testing.T) {
 		t.Fatal()
 	}
 
-	if len(o.K.AllNamedIndividualDecls()) != 4 {
+	if len(o.K.AllNamedIndividualDecls()) != 8 { // 4 declared, 4 implicitly by use
 		t.Fatal(o.K.AllNamedIndividualDecls())
 	}
 	if !k.NamedIndividualDeclExists("localprefix#MyQuattroFormaggio", false) {
 		t.Fatal()
 	}
+	if !k.NamedIndividualDeclExists("localprefix#MeineMargherita", true) {
+		t.Fatal()
+	}
 
 	// === SubDataPropertyOfs
 	if len(o.K.AllSubDataPropertyOfs()) != 2 {
@@ -344,11 +348,17 @@ func TestParseObjectPropertyAssertion(t *testing.T) {
 	var err error
 	o := testOntology()
 	o.Prefixes[""] = "abc#"
+	o.Prefixes["abc"] = "abc#"
 
-	// decls := o.DeclStore
-	p = mock.NewTestParser(`EquivalentClasses(:InterestingPizza ObjectIntersectionOf(:Pizza ObjectMinCardinality(3 :hasTopping)))	`)
-
-	p = mock.NewTestParser(`ObjectPropertyAssertion(:genügt-wem :Mälzers-Kochkunst :Mälzers-Gästen)`)
+	decls := o.DeclStore
+	decls.StoreObjectPropertyDecl("abc#genügt-wem")
+	decls.StoreObjectPropertyDecl("abc#isPraisedBy")
+	decls.StoreAnnotationPropertyDecl("abc#comment")
+	decls.StoreNamedIndividualDecl("abc#Mälzers-Kochkunst")
+	decls.StoreNamedIndividualDecl("abc#Mälzers-Gästen")
+
+	// the 2nd individual as full IRI, and with another prefix for the same IRI
+	p = mock.NewTestParser(`ObjectPropertyAssertion(:genügt-wem :Mälzers-Kochkunst <abc#Mälzers-Gästen>)`)
 	err = o.parseObjectPropertyAssertion(p)
 	if err != nil {
 		t.Fatal(err)
@@ -359,8 +369,346 @@ func TestParseObjectPropertyAssertion(t *testing.T) {
 
 	var expr assertions.ObjectPropertyAssertion
 	expr = o.K.AllObjectPropertyAssertions()[0]
-	if expr.PN != "abc#genügt-wem" {
-		t.Fatal(expr.PN)
+	if expr.P.(*decl.ObjectPropertyDecl).IRI != "abc#genügt-wem" {
+		t.Fatal(expr.P)
+	}
+	if expr.A1.Name != "abc#Mälzers-Kochkunst" || expr.A2.Decl.IRI != "abc#Mälzers-Gästen" {
+		t.Fatal(expr)
+	}
+
+	p = mock.NewTestParser(`ObjectPropertyAssertion(:genügt-wem abc:Mälzers-Kochkunst abc:Mälzers-Gästen)`)
+	err = o.parseObjectPropertyAssertion(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the same assertion, which is not stored again
+	if len(o.K.AllObjectPropertyAssertions()) != 1 || o.K.AllObjectPropertyAssertions()[0].A2 != expr.A2 {
+		t.Fatal(o.K.AllObjectPropertyAssertions())
+	}
+
+	// with annotation, and an inverse property
+	p = mock.NewTestParser(`ObjectPropertyAssertion(Annotation(:comment "inverse") ObjectInverseOf(:isPraisedBy) :Mälzers-Kochkunst :Mälzers-Gästen)`)
+	err = o.parseObjectPropertyAssertion(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := o.K.AllObjectPropertyAssertions()[1].P.(*properties.ObjectInverseOf); !ok {
+		t.Fatal(o.K.AllObjectPropertyAssertions()[1])
+	}
+
+	p = mock.NewTestParser(`NegativeObjectPropertyAssertion(Annotation(:comment "negative") :genügt-wem :Mälzers-Gästen :Mälzers-Kochkunst)`)
+	err = o.parseNegativeObjectPropertyAssertion(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.K.AllNegativeObjectPropertyAssertions()) != 1 {
+		t.Fatal(o.K.AllNegativeObjectPropertyAssertions())
+	}
+
+	// undeclared individuals are rejected with ExplicitDecls
+	p = mock.NewTestParser(`ObjectPropertyAssertion(:genügt-wem :Mälzers-Kochkunst :Unbekannt)`)
+	if err = o.parseObjectPropertyAssertion(p); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParseImport(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.Prefixes["ex"] = "http://example.com/"
+
+	p = mock.NewTestParser(`Ontology(<urn:test> Import(<http://example.com/pizza.owl>) Import(ex:food) Declaration(Class(:Pizza)))`)
+	err = o.Parse(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Imports) != 2 {
+		t.Fatal(o.Imports)
+	}
+	if o.Imports[0] != "http://example.com/pizza.owl" {
+		t.Fatal(o.Imports[0])
+	}
+	if o.Imports[1] != "http://example.com/food" {
+		t.Fatal(o.Imports[1])
+	}
+}
+
+func TestParseHasKey(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.K.(*storedefaults.DefaultK).ExplicitDecls = false
+
+	p = mock.NewTestParser(`HasKey(Annotation(:comment "by name and owner") :Pizza (:hasOwner ObjectInverseOf(:bakes)) (:hasName))`)
+	err = o.parseHasKey(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p = mock.NewTestParser(`HasKey(:Person () (:hasSSN))`)
+	err = o.parseHasKey(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = p.ConsumeTokens(parser.EOF); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(o.K.AllHasKeys()) != 2 {
+		t.Fatal(o.K.AllHasKeys())
+	}
+	expr := o.K.AllHasKeys()[0]
+	if expr.C.(*decl.ClassDecl).IRI != "localprefix#Pizza" {
+		t.Fatal(expr.C)
+	}
+	if len(expr.Ps) != 2 || len(expr.Rs) != 1 {
+		t.Fatal(expr)
+	}
+	if _, ok := expr.Ps[1].(*properties.ObjectInverseOf); !ok {
+		t.Fatal(expr.Ps[1])
+	}
+	if expr.Rs[0].(*decl.DataPropertyDecl).IRI != "localprefix#hasName" {
+		t.Fatal(expr.Rs[0])
+	}
+	expr = o.K.AllHasKeys()[1]
+	if len(expr.Ps) != 0 || len(expr.Rs) != 1 {
+		t.Fatal(expr)
+	}
+
+	// no properties at all:
+	p = mock.NewTestParser(`HasKey(:Person () ())`)
+	if err = o.parseHasKey(p); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParseDataPropertyRangeWithRestriction(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.Prefixes["xsd"] = builtindatatypes.PRE_XSD
+	o.K.(*storedefaults.DefaultK).ExplicitDecls = false
+
+	p = mock.NewTestParser(`DataPropertyRange(:age DatatypeRestriction(xsd:integer xsd:minInclusive 0))`)
+	err = o.parseDataPropertyRange(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.K.AllDataPropertyRanges()) != 1 {
+		t.Fatal(o.K.AllDataPropertyRanges())
+	}
+	D, ok := o.K.AllDataPropertyRanges()[0].D.(*facets.DatatypeRestriction)
+	if !ok {
+		t.Fatal(o.K.AllDataPropertyRanges()[0].D)
+	}
+	if D.DN.(*facets.BuiltinDatatype).DatatypeIRI != builtindatatypes.PRE_XSD+"integer" {
+		t.Fatal(D.DN)
+	}
+	if len(D.FVPairs) != 1 || D.FVPairs[0].F != facets.Xsd_minInclusive || D.FVPairs[0].V.Value != "0" {
+		t.Fatal(D.FVPairs)
+	}
+}
+
+func TestParseDatatypeDefinition(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.Prefixes["xsd"] = builtindatatypes.PRE_XSD
+
+	p = mock.NewTestParser(`Ontology(
+	Declaration(Datatype(:Percentage))
+	Declaration(DataProperty(:share))
+	Declaration(DataProperty(:rebate))
+	Declaration(AnnotationProperty(:comment))
+	DataPropertyRange(:rebate :Percentage)
+	DatatypeDefinition(Annotation(:comment "0 to 100") :Percentage DatatypeRestriction(xsd:decimal xsd:minInclusive 0 xsd:maxInclusive 100))
+	DataPropertyRange(:share :Percentage)
+)`)
+	err = o.Parse(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.K.AllDatatypeDefinitions()) != 1 {
+		t.Fatal(o.K.AllDatatypeDefinitions())
+	}
+	def := o.K.AllDatatypeDefinitions()[0]
+	if def.DN.(*facets.CustomNamedDatatype).DatatypeIRI != "localprefix#Percentage" {
+		t.Fatal(def.DN)
+	}
+	if _, ok := def.D.(*facets.DatatypeRestriction); !ok {
+		t.Fatal(def.D)
+	}
+
+	// the datatype, when used, is resolved to its definition, also if the definition follows:
+	if len(o.K.AllDataPropertyRanges()) != 2 {
+		t.Fatal(o.K.AllDataPropertyRanges())
+	}
+	for _, x := range o.K.AllDataPropertyRanges() {
+		D := x.D.(*facets.CustomNamedDatatype)
+		if D.Definition() != def.D {
+			t.Fatal(x.R, D.Definition())
+		}
+	}
+
+	// builtin datatypes cannot be redefined
+	p = mock.NewTestParser(`DatatypeDefinition(xsd:integer xsd:decimal)`)
+	if err = o.parseDatatypeDefinition(p); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParsePropertyAxioms(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.K.(*storedefaults.DefaultK).ExplicitDecls = false
+
+	p = mock.NewTestParser(`Ontology(
+	EquivalentObjectProperties(:hasChild :hasKid ObjectInverseOf(:hasParent))
+	DisjointObjectProperties(:hasSon :hasDaughter)
+	EquivalentDataProperties(:hasName :seeks)
+	DisjointDataProperties(:hasName :hasAddress :hasAge)
+	SubObjectPropertyOf(ObjectPropertyChain(:hasParent :hasParent) :hasGrandparent)
+	SubObjectPropertyOf(Annotation(:comment "uncle") ObjectPropertyChain(:hasFather ObjectInverseOf(:hasChild) :hasSon) :hasUncle)
+	SubObjectPropertyOf(:hasSon :hasChild)
+)`)
+	err = o.Parse(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(o.K.AllEquivalentObjectProperties()) != 1 || len(o.K.AllEquivalentObjectProperties()[0].Ps) != 3 {
+		t.Fatal(o.K.AllEquivalentObjectProperties())
+	}
+	if _, ok := o.K.AllEquivalentObjectProperties()[0].Ps[2].(*properties.ObjectInverseOf); !ok {
+		t.Fatal(o.K.AllEquivalentObjectProperties()[0].Ps[2])
+	}
+	if len(o.K.AllDisjointObjectProperties()) != 1 || len(o.K.AllDisjointObjectProperties()[0].Ps) != 2 {
+		t.Fatal(o.K.AllDisjointObjectProperties())
+	}
+	if len(o.K.AllEquivalentDataProperties()) != 1 || len(o.K.AllEquivalentDataProperties()[0].Rs) != 2 {
+		t.Fatal(o.K.AllEquivalentDataProperties())
+	}
+	if len(o.K.AllDisjointDataProperties()) != 1 || len(o.K.AllDisjointDataProperties()[0].Rs) != 3 {
+		t.Fatal(o.K.AllDisjointDataProperties())
+	}
+
+	chains := o.K.AllSubObjectPropertyChainOfs()
+	if len(chains) != 2 {
+		t.Fatal(chains)
+	}
+	if len(chains[0].Chain) != 2 || chains[0].P.(*decl.ObjectPropertyDecl).IRI != "localprefix#hasGrandparent" {
+		t.Fatal(chains[0])
+	}
+	if len(chains[1].Chain) != 3 {
+		t.Fatal(chains[1])
+	}
+	if len(o.K.AllSubObjectPropertyOfs()) != 1 {
+		t.Fatal(o.K.AllSubObjectPropertyOfs())
+	}
+
+	// a single property is not enough
+	p = mock.NewTestParser(`DisjointObjectProperties(:hasSon)`)
+	if err = o.parseDisjointObjectProperties(p); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParseSameIndividualNegativeDataPropertyAssertionDisjointUnion(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.K.(*storedefaults.DefaultK).ExplicitDecls = false
+	o.Prefixes["xsd"] = builtindatatypes.PRE_XSD
+
+	p = mock.NewTestParser(`Ontology(
+	SameIndividual(:Peter :Pete :PeterGriffin)
+	NegativeDataPropertyAssertion(:hasAge :Meg "5"^^xsd:integer)
+	DisjointUnion(Annotation(:comment "parents") :Parent :Mother :Father)
+)`)
+	err = o.Parse(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(o.K.AllSameIndividuals()) != 1 || len(o.K.AllSameIndividuals()[0].As) != 3 {
+		t.Fatal(o.K.AllSameIndividuals())
+	}
+	nas := o.K.AllNegativeDataPropertyAssertions()
+	if len(nas) != 1 || nas[0].V.Value != "5" {
+		t.Fatal(nas)
+	}
+	dus := o.K.AllDisjointUnions()
+	if len(dus) != 1 || len(dus[0].DisjointClasses) != 2 || dus[0].CN.(*decl.ClassDecl).IRI != "localprefix#Parent" {
+		t.Fatal(dus)
+	}
+
+	// a single individual is not enough
+	p = mock.NewTestParser(`SameIndividual(:Peter)`)
+	if err = o.parseSameIndividual(p); err == nil {
+		t.Fatal()
+	}
+	// the disjoint union needs a named class first
+	p = mock.NewTestParser(`DisjointUnion(ObjectComplementOf(:Parent) :Mother :Father)`)
+	if err = o.parseDisjointUnion(p); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParseAnonymousIndividuals(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	o := testOntology()
+	o.K.(*storedefaults.DefaultK).ExplicitDecls = false
+
+	p = mock.NewTestParser(`Ontology(
+	ClassAssertion(:Pizza _:p1)
+	ObjectPropertyAssertion(:hasTopping _:p1 _:t1)
+	SameIndividual(_:p1 :Margherita)
+	EquivalentClasses(:Pizzas ObjectOneOf(_:p1 :Margherita))
+)`)
+	err = o.Parse(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := o.K.AllClassAssertions()[0].A
+	if !a.IsAnonymous() || a.NodeID != "_:p1" || a.DocumentID != p.DocumentID() {
+		t.Fatal(a)
+	}
+	opa := o.K.AllObjectPropertyAssertions()[0]
+	if opa.A1 != a || !opa.A2.IsAnonymous() || opa.A2 == a {
+		t.Fatal(opa)
+	}
+	as := o.K.AllSameIndividuals()[0].As
+	if as[0] != a || as[1].IsAnonymous() || as[1].Name != "localprefix#Margherita" {
+		t.Fatal(as)
+	}
+
+	// the same node ID in another document is another individual
+	p = mock.NewTestParser(`ClassAssertion(:Pizza _:p1)`)
+	if err = o.parseClassAssertion(p); err != nil {
+		t.Fatal(err)
+	}
+	other := o.K.AllClassAssertions()[1].A
+	if other.NodeID != a.NodeID || other == a {
+		t.Fatal(other, a)
+	}
+
+	// annotation subjects and values keep the document, too
+	for i := 0; i < 2; i++ {
+		p = mock.NewTestParser(`AnnotationAssertion(Annotation(:seeAlso _:v1) :seeAlso _:p1 _:t1)`)
+		if err = o.parseAnnotationAssertion(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	aas := o.K.AllAnnotationAssertions()
+	if len(aas) != 2 || aas[1].S != "_:p1" || aas[1].T != "_:t1" || aas[1].DocumentID != p.DocumentID() || aas[0].DocumentID == aas[1].DocumentID {
+		t.Fatal(aas)
+	}
+	anns := o.K.(*storedefaults.DefaultK).AxiomAnnotations(storedefaults.KindAnnotationAssertion, 1)
+	if len(anns) != 1 || anns[0].T() != "_:v1" || anns[0].DocumentID() != p.DocumentID() {
+		t.Fatal(anns)
 	}
 }
 
@@ -977,7 +1325,7 @@ Ontology(
 	# and Classes 4 and 5 shortened implicit:
 	SubClassOf(:JamAndKidneyPizza :BritishPizza)
 
-	# and an already implicit declared Class 6 explicit now:
+	# and the already implicit declared Class 5 explicit now:
 	Declaration(Class(:BritishPizza))
 )
 `
@@ -0,0 +1,55 @@
+//go:build go1.18
+// +build go1.18
+
+package parsefuncs
+
+import (
+	"testing"
+
+	"github.com/shful/gofp/mock"
+	"github.com/shful/gofp/tech"
+)
+
+func FuzzParseClassExpression(f *testing.F) {
+	for _, seed := range []string{
+		`owl:Thing`,
+		`:CheeseTopping`,
+		`<http://www.example.org/(*§!_)someWildÜRI/>`,
+		`ObjectMinCardinality(3 :hasTopping)`,
+		`ObjectIntersectionOf(:Pizza ObjectMinCardinality(3 :hasTopping :CheeseTopping))`,
+		`ObjectIntersectionOf(:Pizza DataHasValue(:hasCalories "150"^^xsd:int))`,
+		`ObjectUnionOf(ObjectComplementOf(:Pizza) ObjectOneOf(:a _:b))`,
+		`DataSomeValuesFrom(:hasCalories DatatypeRestriction(xsd:integer xsd:minInclusive "0"^^xsd:integer))`,
+		`ObjectAllValuesFrom(ObjectInverseOf(:hasTopping) ObjectHasSelf(:hasTopping))`,
+		`DataExactCardinality(2 :hasCalories DataUnionOf(xsd:int DataOneOf("a" 1)))`,
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		decls, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().AddPrefixes("").
+			AddClassDecl(*tech.MustNewFragmentedIRI("longname-for-#", "Pizza")).
+			AddClassDecl(*tech.MustNewFragmentedIRI("longname-for-#", "CheeseTopping")).
+			AddObjectPropertyDecl(*tech.MustNewFragmentedIRI("longname-for-#", "hasTopping")).
+			AddDataPropertyDecl(*tech.MustNewFragmentedIRI("longname-for-#", "hasCalories")).
+			Get()
+		ParseClassExpression(mock.NewTestParser(s), decls, prefixes)
+	})
+}
+
+func FuzzParseOWLLiteral(f *testing.F) {
+	for _, seed := range []string{
+		`1`,
+		`3.0`,
+		`true`,
+		`"Hello Wörld"@en`,
+		`"099"^^xsd:positiveInteger`,
+		`"42"^^<http://www.w3.org/2001/XMLSchema#integer>`,
+		`"0.0"@LongLangTäg^^xsd:string`,
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		_, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().Get()
+		ParseOWLLiteral(mock.NewTestParser(s), prefixes)
+	})
+}
@@ -45,6 +45,7 @@ func parseNPC(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (n in
 		if err != nil {
 			return
 		}
+		isQualified = true
 	}
 	err = p.ConsumeTokens(parser.B2)
 
@@ -74,7 +75,7 @@ func parseNRD(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (n in
 	default:
 		D, err = ParseDataRange(p, decls, prefixes)
 		if err != nil {
-			err = pos.Errorf("parsing D in DataExactCardinality:%v", err)
+			err = pos.EnrichErrorMsg(err, "parsing D in DataExactCardinality")
 			return
 		}
 		isQualified = true
@@ -151,12 +152,14 @@ const (
 	ArgtypeLiteral
 )
 
-// Parset reads IRI or anonymous individual, which is shortened as "s" in the OWL spec
+// Parses reads IRI or anonymous individual, which is shortened as "s" in the OWL spec.
+// For an anonymous individual, expr is its node ID like "_:a1", which is local to the document of p.
 func Parses(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr string, argtype ARGTYPE, err error) {
 	pos := p.Pos()
 
-	_, expr, _ = p.ScanIgnoreWSAndComment()
-	if expr == "_" { //todo Is this right?
+	var tok parser.Token
+	tok, expr, _ = p.ScanIgnoreWSAndComment()
+	if tok == parser.NODEID {
 		argtype = ArgtypeAnonymousIndividual
 		return
 	}
@@ -197,19 +200,20 @@ func ParseA(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr m
 	var ok bool
 	expr, ok = decls.AnnotationPropertyDecl(AIRI.String())
 	if !ok {
-		err = pos.Errorf("undeclared AnnotationProperty")
+		err = pos.ErrorfKind(parser.ErrUndeclaredEntity, "undeclared AnnotationProperty")
 		return
 	}
 	return
 }
 
-// Parset reads IRI or literal or anonymous individual, which is shortened as "t" in the OWL spec
+// Parset reads IRI or literal or anonymous individual, which is shortened as "t" in the OWL spec.
+// For an anonymous individual, expr is its node ID like "_:a1", as with Parses.
 func Parset(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr string, argtype ARGTYPE, err error) {
 	var tok parser.Token
 	pos := p.Pos()
 
 	tok, expr, _ = p.ScanIgnoreWSAndComment()
-	if expr == "_" { //todo Is this right?
+	if tok == parser.NODEID {
 		argtype = ArgtypeAnonymousIndividual
 		return
 	}
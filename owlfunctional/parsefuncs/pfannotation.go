@@ -26,7 +26,8 @@ func ParseAnnotation(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes
 	}
 
 	var t string
-	t, _, err = Parset(p, decls, prefixes)
+	var argtype ARGTYPE
+	t, argtype, err = Parset(p, decls, prefixes)
 	if err != nil {
 		err = pos.EnrichErrorMsg(err, "reading 2nd param in Annotation")
 		return
@@ -36,6 +37,10 @@ func ParseAnnotation(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes
 		return
 	}
 
+	if argtype == ArgtypeAnonymousIndividual {
+		expr = annotations.NewAnonymousAnnotation(A, t, p.DocumentID())
+		return
+	}
 	expr = annotations.NewAnnotation(A, t)
 	return
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/shful/gofp/owlfunctional/literal"
 	"github.com/shful/gofp/owlfunctional/meta"
 	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/owlfunctional/structural"
 	"github.com/shful/gofp/parsehelper"
 	"github.com/shful/gofp/store"
 	"github.com/shful/gofp/tech"
@@ -59,7 +60,7 @@ func ParseClassExpression(p *parser.Parser, decls store.Decls, prefixes tech.Pre
 		ident, err = parsehelper.ParseAndResolveIRI(p, prefixes)
 
 		if err != nil {
-			err = pos.ErrorfUnexpectedToken(tok, lit, "IRI as Class Expression found but parse failed:"+err.Error())
+			err = pos.EnrichErrorMsg(err, "IRI as Class Expression found but parse failed")
 			return
 		}
 
@@ -71,7 +72,7 @@ func ParseClassExpression(p *parser.Parser, decls store.Decls, prefixes tech.Pre
 			case "Nothing":
 				expr = &classexpression.OWLNothing{}
 			default:
-				err = pos.Errorf(`unexpected OWL name "%v"`, ident.Fragment)
+				err = pos.ErrorfKind(parser.ErrInvalidIRI, `unexpected OWL name "%v"`, ident.Fragment)
 			}
 			return
 		} else {
@@ -81,17 +82,17 @@ func ParseClassExpression(p *parser.Parser, decls store.Decls, prefixes tech.Pre
 		var ok bool
 		expr, ok = decls.ClassDecl(ident.String())
 		if !ok {
-			err = pos.Errorf("Unknown ref to %v. Expected class expression.", ident)
+			err = pos.ErrorfKind(parser.ErrUndeclaredEntity, "Unknown ref to %v. Expected class expression.", ident)
 		}
 	default:
-		err = pos.Errorf("Expected class expression (found:%v which seems something different)", lit)
+		err = pos.ErrorfExpected(tok, "class expression", "Expected class expression (found:%v which seems something different)", lit)
 	}
 
 	return
 }
 
 func parseDataAllValuesFrom(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.ClassExpression, err error) {
-	if err = p.ConsumeTokens(parser.B1); err != nil {
+	if err = p.ConsumeTokens(parser.DataAllValuesFrom, parser.B1); err != nil {
 		return
 	}
 
@@ -282,6 +283,9 @@ func parseObjectHasSelf(p *parser.Parser, decls store.Decls, prefixes tech.Prefi
 	if err != nil {
 		return
 	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
 
 	expr = &classexpression.ObjectHasSelf{P: P}
 	return
@@ -332,7 +336,7 @@ func parseObjectComplementOf(p *parser.Parser, decls store.Decls, prefixes tech.
 		return
 	}
 	if len(Cs) != 1 {
-		err = pos.Errorf("wrong param count (%d) in ObjectComplementOf, expected 1", len(Cs))
+		err = pos.ErrorfKind(parser.ErrParamCount, "wrong param count (%d) in ObjectComplementOf, expected 1", len(Cs))
 		return
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
@@ -354,13 +358,19 @@ func parseObjectIntersectionOf(p *parser.Parser, decls store.Decls, prefixes tec
 		return
 	}
 	if len(Cs) < 2 { //todo allow 1 or even 0==Nothing?
-		err = pos.Errorf("not enough params (%d) in ObjectIntersectionOf", len(Cs))
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in ObjectIntersectionOf", len(Cs))
 		return
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
 		return
 	}
-	expr = &classexpression.ObjectIntersectionOf{Cs}
+	if p.Dedup() {
+		if Cs = structural.Dedup(Cs); len(Cs) == 1 {
+			expr = Cs[0]
+			return
+		}
+	}
+	expr = &classexpression.ObjectIntersectionOf{Cs: Cs}
 	return
 }
 
@@ -378,6 +388,9 @@ func parseObjectOneOf(p *parser.Parser, decls store.Decls, prefixes tech.Prefixe
 	if err = p.ConsumeTokens(parser.B2); err != nil {
 		return
 	}
+	if p.Dedup() {
+		as = structural.DedupIndividuals(as)
+	}
 	expr = &classexpression.ObjectOneOf{As: as}
 
 	return
@@ -395,13 +408,19 @@ func parseObjectUnionOf(p *parser.Parser, decls store.Decls, prefixes tech.Prefi
 		return
 	}
 	if len(Cs) < 2 {
-		err = pos.Errorf("not enough params (%d) in ObjectUnionOf", len(Cs))
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in ObjectUnionOf", len(Cs))
 		return
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
 		return
 	}
-	expr = &classexpression.ObjectUnionOf{Cs}
+	if p.Dedup() {
+		if Cs = structural.Dedup(Cs); len(Cs) == 1 {
+			expr = Cs[0]
+			return
+		}
+	}
+	expr = &classexpression.ObjectUnionOf{Cs: Cs}
 	return
 }
 
@@ -165,6 +165,49 @@ func TestParseObjectMinCardinality(t *testing.T) {
 	}
 }
 
+func TestParseQualifiedObjectMinCardinality(t *testing.T) {
+	decls, prefixes := mock.NewBuilder().AddPrefixes("").
+		AddClassDecl(*tech.MustNewFragmentedIRI("longname-for-#", "Cheese")).
+		AddObjectPropertyDecl(*tech.MustNewFragmentedIRI("longname-for-#", "hasTopping")).
+		Get()
+
+	p := mock.NewTestParser(`ObjectMinCardinality(3 :hasTopping :Cheese)`)
+	expr, err := ParseClassExpression(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, ok := expr.(*classexpression.ObjectQualifiedMinCardinality); !ok || x.N != 3 || x.C == nil {
+		t.Fatal(expr)
+	}
+}
+
+func TestParseDataAllValuesFromAndObjectHasSelf(t *testing.T) {
+	decls, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().AddPrefixes("").
+		AddDataPropertyDecl(*tech.MustNewFragmentedIRI("longname-for-#", "hasCalories")).
+		AddObjectPropertyDecl(*tech.MustNewFragmentedIRI("longname-for-#", "likes")).
+		Get()
+
+	p := mock.NewTestParser(`DataAllValuesFrom(:hasCalories xsd:integer) ObjectHasSelf(:likes)`)
+	expr, err := ParseClassExpression(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := expr.(*classexpression.DataAllValuesFrom); !ok {
+		t.Fatal(expr)
+	}
+	// the closing parenthesis of ObjectHasSelf must be consumed
+	expr, err = ParseClassExpression(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := expr.(*classexpression.ObjectHasSelf); !ok {
+		t.Fatal(expr)
+	}
+	if err = p.ConsumeTokens(parser.EOF); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestParseClassExpression_ObjectMinCardinality(t *testing.T) {
 	var p *parser.Parser
 	var err error
@@ -246,3 +289,41 @@ func TestParseObjectIntersectionOf2(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestParseObjectUnionOfDedup(t *testing.T) {
+	decls, prefixes := mock.NewBuilder().AddPrefixes("").
+		AddClassDecl(*tech.MustNewFragmentedIRI("longname-for-#", "Pizza")).
+		AddClassDecl(*tech.MustNewFragmentedIRI("longname-for-#", "Pasta")).
+		Get()
+
+	p := mock.NewTestParser(`ObjectUnionOf(:Pizza :Pasta :Pizza)`)
+	p.SetDedup(true)
+	expr, err := parseObjectUnionOf(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := expr.(*classexpression.ObjectUnionOf); len(x.Cs) != 2 {
+		t.Fatal(x.Cs)
+	}
+
+	// a single remaining operand replaces the union
+	p = mock.NewTestParser(`ObjectUnionOf(:Pizza :Pizza)`)
+	p.SetDedup(true)
+	expr, err = parseObjectUnionOf(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x, ok := expr.(*decl.ClassDecl); !ok || x.IRI != "longname-for-#Pizza" {
+		t.Fatal(expr)
+	}
+
+	// without dedup, the operands are kept
+	p = mock.NewTestParser(`ObjectUnionOf(:Pizza :Pizza)`)
+	expr, err = parseObjectUnionOf(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x := expr.(*classexpression.ObjectUnionOf); len(x.Cs) != 2 {
+		t.Fatal(x.Cs)
+	}
+}
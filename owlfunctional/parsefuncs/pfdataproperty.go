@@ -29,7 +29,7 @@ func ParseDataProperty(p *parser.Parser, decls store.Decls, prefixes tech.Prefix
 		case "bottomDataProperty":
 			expr = &properties.OWLBottomDataProperty{}
 		default:
-			err = pos.Errorf(`unexpected OWL property "%v"`, ident.Fragment)
+			err = pos.ErrorfKind(parser.ErrInvalidIRI, `unexpected OWL property "%v"`, ident.Fragment)
 		}
 		return
 	}
@@ -37,7 +37,31 @@ func ParseDataProperty(p *parser.Parser, decls store.Decls, prefixes tech.Prefix
 	var ok bool
 	expr, ok = decls.DataPropertyDecl(ident.String())
 	if !ok {
-		err = pos.Errorf("Unknown ref to %v. Expected datatype property.", ident)
+		err = pos.ErrorfKind(parser.ErrUndeclaredEntity, "Unknown ref to %v. Expected datatype property.", ident)
 	}
 	return
 }
+
+// ParseDataPropertiesUntilB2 parses all DataProperties until ")" is found
+// The closing ")" is not consumed.
+func ParseDataPropertiesUntilB2(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (Rs []meta.DataProperty, err error) {
+
+	var tok parser.Token
+	var R meta.DataProperty
+
+	for {
+		tok, _, _ = p.ScanIgnoreWSAndComment()
+		p.Unscan()
+		if tok == parser.B2 {
+			break
+		}
+
+		R, err = ParseDataProperty(p, decls, prefixes)
+		if err != nil {
+			return
+		}
+		Rs = append(Rs, R)
+	}
+
+	return
+}
@@ -0,0 +1,138 @@
+package parsefuncs
+
+import (
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/owlfunctional/structural"
+	"github.com/shful/gofp/store"
+	"github.com/shful/gofp/tech"
+)
+
+func parseDataComplementOf(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.DataRange, err error) {
+	if err = p.ConsumeTokens(parser.DataComplementOf, parser.B1); err != nil {
+		return
+	}
+
+	var Ds []meta.DataRange
+	pos := p.Pos()
+	Ds, err = ParseDataRangesUntilB2(p, decls, prefixes)
+	if err != nil {
+		return
+	}
+	if len(Ds) != 1 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "wrong param count (%d) in DataComplementOf, expected 1", len(Ds))
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	expr = &dataranges.DataComplementOf{D: Ds[0]}
+	return
+}
+
+func parseDataIntersectionOf(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.DataRange, err error) {
+	if err = p.ConsumeTokens(parser.DataIntersectionOf, parser.B1); err != nil {
+		return
+	}
+
+	var Ds []meta.DataRange
+	pos := p.Pos()
+	Ds, err = ParseDataRangesUntilB2(p, decls, prefixes)
+	if err != nil {
+		return
+	}
+	if len(Ds) < 2 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in DataIntersectionOf", len(Ds))
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	if p.Dedup() {
+		if Ds = structural.DedupDataRanges(Ds); len(Ds) == 1 {
+			expr = Ds[0]
+			return
+		}
+	}
+	expr = &dataranges.DataIntersectionOf{Ds: Ds}
+	return
+}
+
+func parseDataOneOf(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.DataRange, err error) {
+	if err = p.ConsumeTokens(parser.DataOneOf, parser.B1); err != nil {
+		return
+	}
+
+	var Vs []literal.OWLLiteral
+	pos := p.Pos()
+	Vs, err = ParseOWLLiteralsUntilB2(p, prefixes)
+	if err != nil {
+		return
+	}
+	if len(Vs) < 1 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in DataOneOf", len(Vs))
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	if p.Dedup() {
+		Vs = structural.DedupLiterals(Vs)
+	}
+	expr = &dataranges.DataOneOf{Vs: Vs}
+	return
+}
+
+func parseDataUnionOf(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.DataRange, err error) {
+	if err = p.ConsumeTokens(parser.DataUnionOf, parser.B1); err != nil {
+		return
+	}
+
+	var Ds []meta.DataRange
+	pos := p.Pos()
+	Ds, err = ParseDataRangesUntilB2(p, decls, prefixes)
+	if err != nil {
+		return
+	}
+	if len(Ds) < 2 {
+		err = pos.ErrorfKind(parser.ErrParamCount, "not enough params (%d) in DataUnionOf", len(Ds))
+		return
+	}
+	if err = p.ConsumeTokens(parser.B2); err != nil {
+		return
+	}
+	if p.Dedup() {
+		if Ds = structural.DedupDataRanges(Ds); len(Ds) == 1 {
+			expr = Ds[0]
+			return
+		}
+	}
+	expr = &dataranges.DataUnionOf{Ds: Ds}
+	return
+}
+
+// ParseDataRangesUntilB2 parses all DataRanges until ")" is found
+// The closing ")" is not consumed.
+func ParseDataRangesUntilB2(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (Ds []meta.DataRange, err error) {
+
+	var tok parser.Token
+	var D meta.DataRange
+
+	for {
+		tok, _, _ = p.ScanIgnoreWSAndComment()
+		p.Unscan()
+		if tok == parser.B2 {
+			break
+		}
+
+		D, err = ParseDataRange(p, decls, prefixes)
+		if err != nil {
+			return
+		}
+		Ds = append(Ds, D)
+	}
+
+	return
+}
@@ -0,0 +1,107 @@
+package parsefuncs
+
+import (
+	"testing"
+
+	"github.com/shful/gofp/mock"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
+)
+
+func TestParseDataComplementOf(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	var expr meta.DataRange
+
+	decls, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().Get()
+
+	p = mock.NewTestParser(`DataComplementOf(xsd:integer)`)
+	expr, err = ParseDataRange(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := expr.(*dataranges.DataComplementOf)
+	if x.D.(*facets.BuiltinDatatype).DatatypeIRI != "http://www.w3.org/2001/XMLSchema#integer" {
+		t.Fatal(x.D)
+	}
+	if err = p.ConsumeTokens(parser.EOF); err != nil {
+		t.Fatal(err)
+	}
+
+	p = mock.NewTestParser(`DataComplementOf(xsd:integer xsd:string)`)
+	if _, err = ParseDataRange(p, decls, prefixes); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParseDataIntersectionAndUnionOf(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	var expr meta.DataRange
+
+	decls, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().Get()
+
+	// recursive data ranges
+	p = mock.NewTestParser(`DataIntersectionOf(xsd:nonNegativeInteger DataUnionOf(xsd:int DataComplementOf(xsd:short)) DataOneOf(1 2))`)
+	expr, err = ParseDataRange(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := expr.(*dataranges.DataIntersectionOf)
+	if len(x.Ds) != 3 {
+		t.Fatal(x.Ds)
+	}
+	y := x.Ds[1].(*dataranges.DataUnionOf)
+	if len(y.Ds) != 2 {
+		t.Fatal(y.Ds)
+	}
+	if _, ok := y.Ds[1].(*dataranges.DataComplementOf); !ok {
+		t.Fatal(y.Ds[1])
+	}
+	if _, ok := x.Ds[2].(*dataranges.DataOneOf); !ok {
+		t.Fatal(x.Ds[2])
+	}
+	if err = p.ConsumeTokens(parser.EOF); err != nil {
+		t.Fatal(err)
+	}
+
+	p = mock.NewTestParser(`DataUnionOf(xsd:int)`)
+	if _, err = ParseDataRange(p, decls, prefixes); err == nil {
+		t.Fatal()
+	}
+	p = mock.NewTestParser(`DataIntersectionOf(xsd:int)`)
+	if _, err = ParseDataRange(p, decls, prefixes); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestParseDataOneOf(t *testing.T) {
+	var p *parser.Parser
+	var err error
+	var expr meta.DataRange
+
+	decls, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().Get()
+
+	p = mock.NewTestParser(`DataOneOf("red" "green"@en "3"^^xsd:integer true)`)
+	expr, err = ParseDataRange(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := expr.(*dataranges.DataOneOf)
+	if len(x.Vs) != 4 {
+		t.Fatal(x.Vs)
+	}
+	if x.Vs[1].Value != "green" || x.Vs[1].LangTag != "en" {
+		t.Fatal(x.Vs[1])
+	}
+	if x.Vs[2].Literaltype != "http://www.w3.org/2001/XMLSchema#integer" {
+		t.Fatal(x.Vs[2])
+	}
+
+	p = mock.NewTestParser(`DataOneOf()`)
+	if _, err = ParseDataRange(p, decls, prefixes); err == nil {
+		t.Fatal()
+	}
+}
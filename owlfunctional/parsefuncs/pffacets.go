@@ -20,18 +20,22 @@ func ParseDataRange(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes)
 
 	switch tok {
 	case parser.DataComplementOf:
-		panic("not implemented")
+		expr, err = parseDataComplementOf(p, decls, prefixes)
 	case parser.DataIntersectionOf:
-		panic("not implemented")
+		expr, err = parseDataIntersectionOf(p, decls, prefixes)
 	case parser.DataUnionOf:
-		panic("not implemented")
+		expr, err = parseDataUnionOf(p, decls, prefixes)
 	case parser.DataOneOf:
-		panic("not implemented")
+		expr, err = parseDataOneOf(p, decls, prefixes)
 	case parser.DatatypeRestriction:
-		parseDatatypeRestriction(p, decls, prefixes)
+		var restriction facets.DatatypeRestriction
+		restriction, err = parseDatatypeRestriction(p, decls, prefixes)
+		if err == nil {
+			expr = &restriction
+		}
 	default:
 		// must be literal, i.e. named datatype (DN)
-		expr, err = parseNamedDatatype(p, decls, prefixes)
+		expr, err = ParseNamedDatatype(p, decls, prefixes)
 	}
 	return
 }
@@ -43,7 +47,7 @@ func parseDatatypeRestriction(p *parser.Parser, decls store.Decls, prefixes tech
 
 	// The DN
 	var DN meta.NamedDatatype
-	DN, err = parseNamedDatatype(p, decls, prefixes)
+	DN, err = ParseNamedDatatype(p, decls, prefixes)
 	if err != nil {
 		return
 	}
@@ -79,28 +83,19 @@ func parseFVPairsUntilB2(p *parser.Parser, prefixes tech.Prefixes) (fvPairs []*f
 		}
 		fvPairs = append(fvPairs, fvPair)
 	}
-	return
 }
 
-func parseFacet(p *parser.Parser) (facet facets.Facet, err error) {
-	var prefix, name string
+func parseFacet(p *parser.Parser, prefixes tech.Prefixes) (facet facets.Facet, err error) {
+	var ident *tech.IRI
 	pos := p.Pos()
-	prefix, name, err = parsehelper.ParsePrefixedName(p)
+	ident, err = parsehelper.ParseAndResolveIRI(p, prefixes)
 	if err != nil {
-		err = pos.EnrichErrorMsg(err, "parsing facet:%v")
+		err = pos.EnrichErrorMsg(err, "parsing facet")
 		return
 	}
-	switch x := parser.FmtPrefixedName(prefix, name); x {
-	case "xsd:maxExclusive":
-		facet = facets.Xsd_maxExclusive
-	case "xsd:maxInclusive":
-		facet = facets.Xsd_maxInclusive
-	case "xsd:minExclusive":
-		facet = facets.Xsd_minExclusive
-	case "xsd:minInclusive":
-		facet = facets.Xsd_minInclusive
-	default:
-		err = pos.Errorf("expected known facet, found %v.", x)
+	var ok bool
+	if facet, ok = facets.FacetByIRI(ident.String()); !ok {
+		err = pos.ErrorfKind(parser.ErrUnexpectedToken, "expected known facet, found %v.", ident)
 	}
 	return
 }
@@ -114,7 +109,7 @@ func parseFVPairUntilB2(p *parser.Parser, prefixes tech.Prefixes) (fvPair *facet
 	if tok == parser.B2 {
 		return
 	}
-	f, err = parseFacet(p)
+	f, err = parseFacet(p, prefixes)
 	if err != nil {
 		return
 	}
@@ -133,7 +128,8 @@ func parseFVPairUntilB2(p *parser.Parser, prefixes tech.Prefixes) (fvPair *facet
 // Datatypes are a kind of data range, which allows them to be used in restrictions.
 // As explained in Section 7, each data range is associated with an arity; for datatypes, the arity is always one.
 // The built-in datatype rdfs:Literal denotes any set of data values that contains the union of the value spaces of all datatypes.
-func parseNamedDatatype(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.NamedDatatype, err error) {
+// A custom datatype which was defined by a DatatypeDefinition axiom before is resolved to its definition, if decls implements store.DatatypeDefinitions.
+func ParseNamedDatatype(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (expr meta.NamedDatatype, err error) {
 	var ident *tech.IRI
 
 	pos := p.Pos()
@@ -145,16 +141,25 @@ func parseNamedDatatype(p *parser.Parser, decls store.Decls, prefixes tech.Prefi
 
 	// Builtin Datatype IRI is allowed:
 	if builtindatatypes.BuiltinDatatypeExists(ident.String()) {
-		expr = &facets.BuiltinDatatype{facets.NamedDatatypeImpl{DatatypeIRI: ident.String()}}
+		expr = &facets.BuiltinDatatype{NamedDatatypeImpl: facets.NamedDatatypeImpl{DatatypeIRI: ident.String()}}
 		return
 	}
 
+	// Defined Datatype IRI is allowed. The definition is resolved when needed, since it may follow in the input:
+	definitions, _ := decls.(store.DatatypeDefinitions)
+	if definitions != nil {
+		if _, ok := definitions.DatatypeDefinition(ident.String()); ok {
+			expr = facets.NewCustomNamedDatatype(ident.String(), definitions)
+			return
+		}
+	}
+
 	// Declared Datatype IRI is allowed:
 	if _, ok := decls.DatatypeDecl(ident.String()); ok {
-		expr = &facets.CustomNamedDatatype{facets.NamedDatatypeImpl{DatatypeIRI: ident.String()}}
+		expr = facets.NewCustomNamedDatatype(ident.String(), definitions)
 		return
 	}
 
-	err = pos.Errorf("unknown datatype literal (%v)", ident)
+	err = pos.ErrorfKind(parser.ErrUndeclaredEntity, "unknown datatype literal (%v)", ident)
 	return
 }
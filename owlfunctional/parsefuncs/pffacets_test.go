@@ -5,6 +5,7 @@ import (
 
 	"github.com/shful/gofp/mock"
 	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/meta"
 	"github.com/shful/gofp/owlfunctional/parser"
 	"github.com/shful/gofp/tech"
 )
@@ -42,3 +43,61 @@ func TestParseDatatypeRestriction(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestParseDataRangeDatatypeRestriction(t *testing.T) {
+
+	var p *parser.Parser
+	var err error
+	var expr meta.DataRange
+
+	decls, prefixes := mock.NewBuilder().AddOWLStandardPrefixes().Get()
+
+	p = mock.NewTestParser(`DatatypeRestriction(xsd:string xsd:minLength 2 xsd:maxLength 8 xsd:pattern "[a-z]+" <http://www.w3.org/2001/XMLSchema#length> 5)`)
+	expr, err = ParseDataRange(p, decls, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, ok := expr.(*facets.DatatypeRestriction)
+	if !ok {
+		t.Fatal(expr)
+	}
+	if x.IsNamedDatatype() {
+		t.Fatal(x)
+	}
+	if len(x.FVPairs) != 4 {
+		t.Fatal(x.FVPairs)
+	}
+	for i, f := range []facets.Facet{facets.Xsd_minLength, facets.Xsd_maxLength, facets.Xsd_pattern, facets.Xsd_length} {
+		if x.FVPairs[i].F != f {
+			t.Fatal(i, x.FVPairs[i])
+		}
+	}
+	if x.FVPairs[2].V.Value != "[a-z]+" {
+		t.Fatal(x.FVPairs[2])
+	}
+	if x.FVPairs[0].F.IRI() != "http://www.w3.org/2001/XMLSchema#minLength" {
+		t.Fatal(x.FVPairs[0].F.IRI())
+	}
+
+	p = mock.NewTestParser(`DatatypeRestriction(xsd:decimal xsd:totalDigits 3 xsd:fractionDigits 1)`)
+	if expr, err = ParseDataRange(p, decls, prefixes); err != nil {
+		t.Fatal(err)
+	}
+	if len(expr.(*facets.DatatypeRestriction).FVPairs) != 2 {
+		t.Fatal(expr)
+	}
+
+	p = mock.NewTestParser(`DatatypeRestriction(rdf:PlainLiteral rdf:langRange "en")`)
+	if expr, err = ParseDataRange(p, decls, prefixes); err != nil {
+		t.Fatal(err)
+	}
+	if expr.(*facets.DatatypeRestriction).FVPairs[0].F != facets.Rdf_langRange {
+		t.Fatal(expr)
+	}
+
+	// unknown facet
+	p = mock.NewTestParser(`DatatypeRestriction(xsd:integer xsd:maxSomething 5)`)
+	if _, err = ParseDataRange(p, decls, prefixes); err == nil {
+		t.Fatal()
+	}
+}
@@ -8,15 +8,32 @@ import (
 	"github.com/shful/gofp/tech"
 )
 
+// ParseIndividual parses a named individual, or an anonymous individual like _:a1.
+// A named individual can be given as full IRI or as prefixed name. It is resolved to its declaration in decls.
+// The node ID of an anonymous individual is scoped to the document which p reads.
 func ParseIndividual(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (a individual.Individual, err error) {
-	var prefix, name string
 	pos := p.Pos()
-	prefix, name, err = parsehelper.ParsePrefixedName(p)
+
+	tok, lit, _ := p.ScanIgnoreWSAndComment()
+	if tok == parser.NODEID {
+		a = individual.NewAnonymous(lit, p.DocumentID())
+		return
+	}
+	p.Unscan()
+
+	var ident *tech.IRI
+	ident, err = parsehelper.ParseAndResolveIRI(p, prefixes)
 	if err != nil {
-		err = pos.Errorf("parsing individual:%v", err)
+		err = pos.EnrichErrorMsg(err, "parsing individual")
+		return
+	}
+
+	d, ok := decls.NamedIndividualDecl(ident.String())
+	if !ok {
+		err = pos.ErrorfKind(parser.ErrUndeclaredEntity, "Unknown ref to %v. Expected individual.", ident)
 		return
 	}
-	a = individual.Individual{Name: parser.FmtPrefixedName(prefix, name)}
+	a = individual.NewNamed(d)
 	return
 }
 
@@ -69,7 +69,7 @@ func ParseOWLLiteral(p *parser.Parser, prefixes tech.Prefixes) (l literal.OWLLit
 			return
 		}
 	default:
-		err = pos.Errorf("unexpected %v when parsing literal", parser.DescribeToklit(tok, lit))
+		err = pos.ErrorfExpected(tok, "literal", "unexpected %v when parsing literal", parser.DescribeToklit(tok, lit))
 		return
 	}
 
@@ -77,6 +77,30 @@ func ParseOWLLiteral(p *parser.Parser, prefixes tech.Prefixes) (l literal.OWLLit
 	return
 }
 
+// ParseOWLLiteralsUntilB2 parses all literals until ")" is found
+// The closing ")" is not consumed.
+func ParseOWLLiteralsUntilB2(p *parser.Parser, prefixes tech.Prefixes) (ls []literal.OWLLiteral, err error) {
+
+	var tok parser.Token
+	var l literal.OWLLiteral
+
+	for {
+		tok, _, _ = p.ScanIgnoreWSAndComment()
+		p.Unscan()
+		if tok == parser.B2 {
+			break
+		}
+
+		l, err = ParseOWLLiteral(p, prefixes)
+		if err != nil {
+			return
+		}
+		ls = append(ls, l)
+	}
+
+	return
+}
+
 // parseSuffixLangtag returns "en", if "@en" is found.
 // Empty string if not @... is found. Error if @<syntactically-invalid-langtag> is found.
 func parseSuffixLangtag(p *parser.Parser) (langtag string, err error) {
@@ -91,12 +115,12 @@ func parseSuffixLangtag(p *parser.Parser) (langtag string, err error) {
 	tok, langtag, pos = p.ScanIgnoreWSAndComment()
 	if tok != parser.IDENT {
 		p.Unscan()
-		err = pos.Errorf("expected langtag, not %v", parser.DescribeToklit(tok, langtag))
+		err = pos.ErrorfExpected(tok, "langtag", "expected langtag, not %v", parser.DescribeToklit(tok, langtag))
 	}
 	return
 }
 
-// parseSuffixLiteraltype expects a prefixed name starting with ^^, e.g. "^^xsd:integer"
+// parseSuffixLiteraltype expects a prefixed name or a full IRI starting with ^^, e.g. "^^xsd:integer"
 // nil if not ^^... is found. Error if ^^<syntactically-invalid-literaltype> is found.
 func parseSuffixLiteraltype(p *parser.Parser, prefixes tech.Prefixes) (ident *tech.IRI, err error) {
 	var tok parser.Token
@@ -108,6 +132,19 @@ func parseSuffixLiteraltype(p *parser.Parser, prefixes tech.Prefixes) (ident *te
 		return
 	}
 
+	tok, _, _ = p.ScanIgnoreWSAndComment()
+	p.Unscan()
+	if tok == parser.IRI {
+		var head, fragment string
+		head, fragment, err = parsehelper.ParseIRIWithFragment(p)
+		if err != nil {
+			err = pos.EnrichErrorMsg(err, "parsing literal type")
+			return
+		}
+		ident, err = tech.NewIRIFromString(head + fragment)
+		return
+	}
+
 	var prefix, name string
 	prefix, name, err = parsehelper.ParsePrefixedName(p)
 	if err != nil {
@@ -119,14 +156,14 @@ func parseSuffixLiteraltype(p *parser.Parser, prefixes tech.Prefixes) (ident *te
 	var resolved string
 	resolved, ok = prefixes.ResolvePrefix(prefix)
 	if !ok {
-		err = pos.Errorf("unknown prefix (%v) in literal type", prefix)
+		err = pos.ErrorfKind(parser.ErrUnknownPrefix, "unknown prefix (%v) in literal type", prefix)
 		return
 	}
 
 	ident, err = tech.NewIRIFromString(resolved + name)
 
 	if err != nil {
-		err = pos.Errorf("prefixed name (%v:%v) resolved to invalid IRI (%v)", prefix, name, resolved+name)
+		err = pos.ErrorfKind(parser.ErrInvalidIRI, "prefixed name (%v:%v) resolved to invalid IRI (%v)", prefix, name, resolved+name)
 		return
 	}
 	return
@@ -139,7 +176,7 @@ func literaltypeMismatch(tok parser.Token, literaltype string) error {
 
 	if mustTok, ok = builtindatatypes.BuiltinDatatypes[literaltype]; ok {
 		if tok != mustTok {
-			return fmt.Errorf("literal type mismatch with value (%v)", literaltype)
+			return fmt.Errorf("%w with value (%v)", parser.ErrLiteralTypeMismatch, literaltype)
 		}
 	}
 	// no mismatch check for custom literaltype
@@ -44,6 +44,16 @@ func TestParseInt(t *testing.T) {
 	if l.LangTag != "" {
 		t.Fatal(l)
 	}
+
+	// literal type as full IRI
+	p = mock.NewTestParser(`"42"^^<http://www.w3.org/2001/XMLSchema#integer>`)
+	l, err = ParseOWLLiteral(p, prefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Value != "42" || l.Literaltype != builtindatatypes.PRE_XSD+"integer" {
+		t.Fatal(l)
+	}
 }
 func TestParseFloat(t *testing.T) {
 	var p *parser.Parser
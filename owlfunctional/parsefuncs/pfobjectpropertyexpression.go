@@ -34,14 +34,14 @@ func ParseObjectPropertyExpression(p *parser.Parser, decls store.Decls, prefixes
 			case "bottomObjectProperty":
 				expr = &properties.OWLBottomObjectProperty{}
 			default:
-				err = pos.Errorf(`unexpected OWL property "%v"`, ident.Fragment)
+				err = pos.ErrorfKind(parser.ErrInvalidIRI, `unexpected OWL property "%v"`, ident.Fragment)
 			}
 			return
 		}
 		var ok bool
 		expr, ok = decls.ObjectPropertyDecl(ident.String())
 		if !ok {
-			err = pos.Errorf("Unknown ref to %v. Expected object property name.", ident)
+			err = pos.ErrorfKind(parser.ErrUndeclaredEntity, "Unknown ref to %v. Expected object property name.", ident)
 		}
 	}
 	return
@@ -55,7 +55,7 @@ func parseObjectInverseOf(p *parser.Parser, prefixes tech.Prefixes) (expr meta.O
 	var ident *tech.IRI
 	ident, err = parsehelper.ParseAndResolveIRI(p, prefixes)
 	if err != nil {
-		err = pos.Errorf("parsing IRI in ObjectInverseOf: %v", err)
+		err = pos.EnrichErrorMsg(err, "parsing IRI in ObjectInverseOf")
 		return
 	}
 	if err = p.ConsumeTokens(parser.B2); err != nil {
@@ -64,3 +64,27 @@ func parseObjectInverseOf(p *parser.Parser, prefixes tech.Prefixes) (expr meta.O
 	expr = &properties.ObjectInverseOf{PN: ident.String()}
 	return
 }
+
+// ParseObjectPropertyExpressionsUntilB2 parses all ObjectPropertyExpressions until ")" is found
+// The closing ")" is not consumed.
+func ParseObjectPropertyExpressionsUntilB2(p *parser.Parser, decls store.Decls, prefixes tech.Prefixes) (Ps []meta.ObjectPropertyExpression, err error) {
+
+	var tok parser.Token
+	var P meta.ObjectPropertyExpression
+
+	for {
+		tok, _, _ = p.ScanIgnoreWSAndComment()
+		p.Unscan()
+		if tok == parser.B2 {
+			break
+		}
+
+		P, err = ParseObjectPropertyExpression(p, decls, prefixes)
+		if err != nil {
+			return
+		}
+		Ps = append(Ps, P)
+	}
+
+	return
+}
@@ -2,13 +2,91 @@ package parser
 
 import (
 	"fmt"
+	"strings"
 )
 
+// ErrorKind is the category of a PErr. Each kind is an error value itself, so that callers
+// can test for it with errors.Is, e.g. errors.Is(err, parser.ErrUnknownPrefix).
+type ErrorKind int
+
+const (
+	// ErrOther is the kind of all errors without a more specific category.
+	ErrOther ErrorKind = iota
+	// ErrUnexpectedToken is a token which is not allowed here. PErr.Expected tells what was allowed.
+	ErrUnexpectedToken
+	// ErrUnexpectedEOF is the end of input before the ontology was complete.
+	ErrUnexpectedEOF
+	// ErrUnknownPrefix is a prefixed name whose prefix was not declared.
+	ErrUnknownPrefix
+	// ErrDuplicatePrefix is a second Prefix declaration with the same name.
+	ErrDuplicatePrefix
+	// ErrInvalidIRI is an IRI which cannot be used, e.g. one from a reserved vocabulary.
+	ErrInvalidIRI
+	// ErrUndeclaredEntity is a reference to an entity which was not declared, or with the wrong type.
+	ErrUndeclaredEntity
+	// ErrLiteralTypeMismatch is a literal whose value does not fit its datatype.
+	ErrLiteralTypeMismatch
+	// ErrDuplicateDeclaration is an entity declared explicitly more than once.
+	ErrDuplicateDeclaration
+	// ErrParamCount is an expression or axiom with too few or too many parameters.
+	ErrParamCount
+	// ErrStore is an error reported by a store, see store.Failer. PErr.Err is the original error.
+	ErrStore
+	// ErrLimitExceeded is an input which exceeds one of the parser limits, see Parser.SetLimits.
+	ErrLimitExceeded
+	// ErrCanceled is a parser stopped by its context, see Parser.SetContext. PErr.Err is the error of the context.
+	ErrCanceled
+)
+
+var errorKindNames = [...]string{
+	ErrOther:                "other error",
+	ErrUnexpectedToken:      "unexpected token",
+	ErrUnexpectedEOF:        "unexpected EOF",
+	ErrUnknownPrefix:        "unknown prefix",
+	ErrDuplicatePrefix:      "duplicate prefix",
+	ErrInvalidIRI:           "invalid IRI",
+	ErrUndeclaredEntity:     "undeclared entity",
+	ErrLiteralTypeMismatch:  "literal type mismatch",
+	ErrDuplicateDeclaration: "duplicate declaration",
+	ErrParamCount:           "wrong parameter count",
+	ErrStore:                "store error",
+	ErrLimitExceeded:        "limit exceeded",
+	ErrCanceled:             "canceled",
+}
+
+// Error satifies the error interface, with the readable name of the kind.
+func (k ErrorKind) Error() string {
+	if k >= 0 && int(k) < len(errorKindNames) {
+		return errorKindNames[k]
+	}
+	return fmt.Sprintf("error kind %d", int(k))
+}
+
+// unexpectedKind is ErrUnexpectedEOF for the EOF token, and ErrUnexpectedToken otherwise.
+func unexpectedKind(tok Token) ErrorKind {
+	if tok == EOF {
+		return ErrUnexpectedEOF
+	}
+	return ErrUnexpectedToken
+}
+
+// PErr is a parser error with its position. Use errors.Is to test for its Kind,
+// and AfterPos for line, column and byte offset.
 type PErr struct {
 	Msg string
 
 	// AfterPos is the Position where the error is directly behind.
 	AfterPos ParserPosition
+
+	// Kind is the category of the error.
+	Kind ErrorKind
+
+	// Expected lists what the parser would have accepted instead, for the kinds ErrUnexpectedToken and ErrUnexpectedEOF.
+	// It can be empty.
+	Expected []string
+
+	// Err is the underlying error, if the PErr was made from another error, e.g. one of a store.
+	Err error
 }
 
 func NewErr(msg string, pos ParserPosition) error {
@@ -21,6 +99,38 @@ func (s *PErr) Error() string {
 	// return s.String()
 }
 
+// Is makes errors.Is(err, kind) true for the Kind of the PErr.
+func (s *PErr) Is(target error) bool {
+	kind, ok := target.(ErrorKind)
+	return ok && kind == s.Kind
+}
+
+// Unwrap returns the underlying error, or nil.
+func (s *PErr) Unwrap() error {
+	return s.Err
+}
+
+// PErrs is the error of a parser in recovery mode (see Parser.SetRecovery), which lists all errors found, in document order.
+type PErrs []*PErr
+
+// Error satifies the error interface, with one line per error.
+func (s PErrs) Error() string {
+	msgs := make([]string, len(s))
+	for i, perr := range s {
+		msgs[i] = perr.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the single errors, for errors.Is and errors.As.
+func (s PErrs) Unwrap() []error {
+	errs := make([]error, len(s))
+	for i, perr := range s {
+		errs[i] = perr
+	}
+	return errs
+}
+
 // String returns a readable representation of error and position.
 // func (s *PErr) String() string {
 // 	return fmt.Sprintf("%v after %v", s.Msg, s.AfterPos.String())
@@ -0,0 +1,34 @@
+//go:build go1.18
+// +build go1.18
+
+package parser
+
+import (
+	"strings"
+	"testing"
+)
+
+func FuzzScanner(f *testing.F) {
+	for _, seed := range []string{
+		`ObjectIntersectionOf(:Pizza ObjectSomeValuesFrom(:hasTopping :Cheese))`,
+		"# comment\r\nDeclaration(Class(<urn:a>))\n",
+		`"Hello Wörld"@en^^xsd:string 42 -3.14 _:a1`,
+		`A(B(C) D) E(F) G H`,
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		p := NewParser(strings.NewReader(s), "Fuzz")
+		// each token reads at least one byte, so EOF comes after len(s)+1 tokens at most
+		for i := 0; i <= len(s); i++ {
+			tok, _, _ := p.ScanIgnoreWSAndComment()
+			if tok == EOF {
+				return
+			}
+		}
+		tok, _, _ := p.Scan()
+		if tok != EOF {
+			t.Fatal("no EOF after", len(s), "bytes")
+		}
+	})
+}
@@ -29,6 +29,7 @@ const (
 	FLOATLIT     // floating point number literal, can be signed
 	LINECOMMENT  // comment until and uncluding line end
 	IRI          // e.g.<http://www.w3.org/2000/01/rdf-schema#>
+	NODEID       // node ID of an anonymous individual, e.g. _:a1
 
 	// Literals
 	IDENT //
@@ -68,18 +69,27 @@ const (
 	DataPropertyRange
 	DataSomeValuesFrom
 	Datatype
+	DatatypeDefinition
 	DatatypeRestriction
 	DataUnionOf
 	Declaration
 	DifferentIndividuals
 	DisjointClasses
+	DisjointDataProperties
+	DisjointObjectProperties
+	DisjointUnion
 	EquivalentClasses
+	EquivalentDataProperties
+	EquivalentObjectProperties
 	FunctionalDataProperty
 	FunctionalObjectProperty
+	HasKey
+	Import
 	InverseFunctionalObjectProperty
 	InverseObjectProperties
 	IrreflexiveObjectProperty
 	NamedIndividual
+	NegativeDataPropertyAssertion
 	NegativeObjectPropertyAssertion
 	ObjectComplementOf
 	ObjectAllValuesFrom
@@ -93,6 +103,7 @@ const (
 	ObjectOneOf
 	ObjectProperty
 	ObjectPropertyAssertion
+	ObjectPropertyChain
 	ObjectPropertyDomain
 	ObjectPropertyRange
 	ObjectSomeValuesFrom
@@ -106,6 +117,7 @@ const (
 	OWLTopDataProperty
 	Prefix
 	ReflexiveObjectProperty
+	SameIndividual
 	SubAnnotationPropertyOf
 	SubClassOf
 	SubDataPropertyOf
@@ -137,19 +149,28 @@ var keywords map[string]Token = map[string]Token{
 	"DataPropertyRange":               DataPropertyRange,
 	"DataSomeValuesFrom":              DataSomeValuesFrom,
 	"Datatype":                        Datatype,
+	"DatatypeDefinition":              DatatypeDefinition,
 	"DatatypeRestriction":             DatatypeRestriction,
 	"DataUnionOf":                     DataUnionOf,
 	"Declaration":                     Declaration,
 	"DifferentIndividuals":            DifferentIndividuals,
 	"DisjointClasses":                 DisjointClasses,
+	"DisjointDataProperties":          DisjointDataProperties,
+	"DisjointObjectProperties":        DisjointObjectProperties,
+	"DisjointUnion":                   DisjointUnion,
 	"EquivalentClasses":               EquivalentClasses,
+	"EquivalentDataProperties":        EquivalentDataProperties,
+	"EquivalentObjectProperties":      EquivalentObjectProperties,
 	"false":                           OWLFalse,
 	"FunctionalDataProperty":          FunctionalDataProperty,
 	"FunctionalObjectProperty":        FunctionalObjectProperty,
+	"HasKey":                          HasKey,
+	"Import":                          Import,
 	"InverseFunctionalObjectProperty": InverseFunctionalObjectProperty,
 	"IrreflexiveObjectProperty":       IrreflexiveObjectProperty,
 	"InverseObjectProperties":         InverseObjectProperties,
 	"NamedIndividual":                 NamedIndividual,
+	"NegativeDataPropertyAssertion":   NegativeDataPropertyAssertion,
 	"NegativeObjectPropertyAssertion": NegativeObjectPropertyAssertion,
 	"ObjectAllValuesFrom":             ObjectAllValuesFrom,
 	"ObjectComplementOf":              ObjectComplementOf,
@@ -163,6 +184,7 @@ var keywords map[string]Token = map[string]Token{
 	"ObjectOneOf":                     ObjectOneOf,
 	"ObjectProperty":                  ObjectProperty,
 	"ObjectPropertyAssertion":         ObjectPropertyAssertion,
+	"ObjectPropertyChain":             ObjectPropertyChain,
 	"ObjectPropertyDomain":            ObjectPropertyDomain,
 	"ObjectPropertyRange":             ObjectPropertyRange,
 	"ObjectSomeValuesFrom":            ObjectSomeValuesFrom,
@@ -170,6 +192,7 @@ var keywords map[string]Token = map[string]Token{
 	"Ontology":                        Ontology,
 	"Prefix":                          Prefix,
 	"ReflexiveObjectProperty":         ReflexiveObjectProperty,
+	"SameIndividual":                  SameIndividual,
 	"SubAnnotationPropertyOf":         SubAnnotationPropertyOf,
 	"SubClassOf":                      SubClassOf,
 	"SubDataPropertyOf":               SubDataPropertyOf,
@@ -179,6 +202,12 @@ var keywords map[string]Token = map[string]Token{
 	"true":                            OWLTrue,
 }
 
+// IsKeyword is true if lit is scanned as keyword token, rather than as IDENT.
+func IsKeyword(lit string) bool {
+	_, ok := keywords[lit]
+	return ok
+}
+
 func Tokenname(t Token) string {
 	switch t {
 	case ILLEGAL:
@@ -209,6 +238,8 @@ func Tokenname(t Token) string {
 		return "IRI"
 	case LINECOMMENT:
 		return "LINECOMMENT"
+	case NODEID:
+		return "NODEID"
 	case B1:
 		return "B1"
 	case B2:
@@ -260,6 +291,9 @@ var lf = '\n'
 // Scanner represents a lexical scanner.
 type Scanner struct {
 	r *bufio.Reader
+
+	offset   int // bytes read so far
+	lastSize int // byte size of the last read rune, 0 after EOF
 }
 
 // NewScanner returns a new instance of Scanner.
@@ -270,15 +304,28 @@ func NewScanner(r io.Reader) *Scanner {
 // read reads the next rune from the bufferred reader.
 // Returns the rune(0) if an error occurs (or io.EOF is returned).
 func (s *Scanner) read() rune {
-	ch, _, err := s.r.ReadRune()
+	ch, size, err := s.r.ReadRune()
 	if err != nil {
+		s.lastSize = 0
 		return eof
 	}
+	s.offset += size
+	s.lastSize = size
 	return ch
 }
 
 // unread places the previously read rune back on the reader.
-func (s *Scanner) unread() { _ = s.r.UnreadRune() }
+func (s *Scanner) unread() {
+	if s.r.UnreadRune() == nil {
+		s.offset -= s.lastSize
+		s.lastSize = 0
+	}
+}
+
+// Offset is the number of bytes read so far, which is the byte offset of the next token.
+func (s *Scanner) Offset() int {
+	return s.offset
+}
 
 // scan returns the next token and literal value.
 func (s *Scanner) scan() (tok Token, lit string) {
@@ -308,6 +355,9 @@ func (s *Scanner) scan() (tok Token, lit string) {
 	} else if ch == '<' {
 		s.unread()
 		return s.scanIRI()
+	} else if ch == '_' {
+		s.unread()
+		return s.scanNodeID()
 		// } else if isSign(ch) || isDigit(ch) {
 	} else if isDigit(ch) {
 		s.unread()
@@ -528,6 +578,35 @@ func (s *Scanner) scanIdent() (tok Token, lit string) {
 	return IDENT, buf.String()
 }
 
+// scanNodeID consumes a node ID like "_:a1", which names an anonymous individual.
+// The returned literal includes the leading "_:".
+// If "_" is not followed by ":" and at least one name character, the token is ILLEGAL.
+func (s *Scanner) scanNodeID() (tok Token, lit string) {
+	var buf bytes.Buffer
+	buf.WriteRune(s.read()) // the underscore
+
+	ch := s.read()
+	if ch != ':' {
+		s.unread()
+		return ILLEGAL, buf.String()
+	}
+	buf.WriteRune(ch)
+
+	for {
+		if ch := s.read(); !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' && ch != '-' {
+			s.unread()
+			break
+		} else {
+			_, _ = buf.WriteRune(ch)
+		}
+	}
+
+	if buf.Len() == 2 {
+		return ILLEGAL, buf.String()
+	}
+	return NODEID, buf.String()
+}
+
 // scanNumber consumes the current number rune and all contiguous number runes.
 // Numbers can be floating point, i.e. contain a single dot.
 // Other formats, especially a leading + or - sign are not considered here.
@@ -148,3 +148,19 @@ func TestIntegerLiteral(t *testing.T) {
 	)
 
 }
+
+func TestScanNodeID(t *testing.T) {
+	var s string
+
+	s = `_:a1 _:node-2)`
+	assertTokLits(t,
+		[]tl{tl{NODEID, "_:a1"}, tl{WS, " "}, tl{NODEID, "_:node-2"}, tl{B2, ")"}, tl{EOF, ""}},
+		NewScanner(strings.NewReader(s)),
+	)
+
+	s = `_ _:`
+	assertTokLits(t,
+		[]tl{tl{ILLEGAL, "_"}, tl{WS, " "}, tl{ILLEGAL, "_:"}, tl{EOF, ""}},
+		NewScanner(strings.NewReader(s)),
+	)
+}
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Limits bound the resources which a Parser spends on one input, for untrusted documents.
+// A zero value means no limit.
+type Limits struct {
+	// MaxBytes is the maximum size of the input.
+	MaxBytes int64
+
+	// MaxTokens is the maximum number of tokens, including whitespace and comments.
+	MaxTokens int64
+
+	// MaxAxioms is the maximum number of statements inside the Ontology, including declarations and annotations.
+	// The Parser itself does not know about axioms; owlfunctional.Ontology.Parse checks this limit.
+	MaxAxioms int
+
+	// MaxDepth is the maximum nesting depth of parentheses. The Ontology( element itself has depth 1,
+	// an axiom inside has depth 2, and each nested expression adds another level.
+	// This limits the recursion depth when parsing class expressions and data ranges.
+	MaxDepth int
+}
+
+// ctxCheckInterval is the number of tokens between two checks of the context, which is cheaper than checking each token.
+const ctxCheckInterval = 1024
+
+// errTooLarge is returned by limitedReader after MaxBytes.
+var errTooLarge = errors.New("input too large")
+
+// limitedReader counts the bytes read, and fails when more than max bytes are there (max > 0).
+type limitedReader struct {
+	r        io.Reader
+	n        int64
+	max      int64
+	exceeded bool
+}
+
+func (r *limitedReader) Read(b []byte) (int, error) {
+	if r.max <= 0 {
+		return r.r.Read(b)
+	}
+	if r.n >= r.max {
+		// the input is allowed to end here, but not to continue
+		var one [1]byte
+		n, err := r.r.Read(one[:])
+		if n > 0 {
+			r.exceeded = true
+			return 0, errTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(b)) > r.max-r.n {
+		b = b[:r.max-r.n]
+	}
+	n, err := r.r.Read(b)
+	r.n += int64(n)
+	return n, err
+}
+
+// SetLimits sets the limits for this Parser. It must be called before parsing starts.
+// When a limit is exceeded, the Parser acts as if the input ended, and Err returns a PErr of the kind ErrLimitExceeded.
+func (p *Parser) SetLimits(limits Limits) {
+	p.limits = limits
+	p.lr.max = limits.MaxBytes
+}
+
+// Limits returns the limits set with SetLimits.
+func (p *Parser) Limits() Limits {
+	return p.limits
+}
+
+// SetContext lets the Parser stop when ctx is done. The Parser then acts as if the input ended,
+// and Err returns a PErr of the kind ErrCanceled, which wraps ctx.Err().
+func (p *Parser) SetContext(ctx context.Context) {
+	p.ctx = ctx
+}
+
+// Err returns the reason why the Parser stopped early, which is an exceeded limit (see SetLimits) or
+// a done context (see SetContext). It is nil otherwise.
+// Any parse error after such a stop is only a consequence, and Err should be reported instead.
+func (p *Parser) Err() error {
+	if p.err == nil {
+		return nil
+	}
+	return p.err
+}
+
+// checkLimits sets p.err, if the parser must stop before reading the next token at pos.
+func (p *Parser) checkLimits(pos ParserPosition) {
+	p.tokens++
+	if p.limits.MaxTokens > 0 && p.tokens > p.limits.MaxTokens {
+		p.err = pos.ErrorfKind(ErrLimitExceeded, "more than %d tokens", p.limits.MaxTokens).(*PErr)
+		return
+	}
+	if p.ctx != nil && p.tokens%ctxCheckInterval == 1 {
+		if err := p.ctx.Err(); err != nil {
+			p.err = pos.ErrorfKind(ErrCanceled, "parsing canceled:%v", err).(*PErr)
+			p.err.Err = err
+		}
+	}
+}
+
+// checkScanned sets p.err, if the scanned token tok exceeds a limit.
+func (p *Parser) checkScanned(tok Token, pos ParserPosition) {
+	if tok == EOF && p.lr.exceeded {
+		p.err = pos.ErrorfKind(ErrLimitExceeded, "more than %d bytes", p.limits.MaxBytes).(*PErr)
+	} else if tok == B1 && p.limits.MaxDepth > 0 && p.pBal >= p.limits.MaxDepth {
+		p.err = pos.ErrorfKind(ErrLimitExceeded, "nesting deeper than %d", p.limits.MaxDepth).(*PErr)
+	}
+}
@@ -3,9 +3,11 @@
 package parser
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"sync/atomic"
 )
 
 var TokenLog bool // print all parsed tokens
@@ -15,7 +17,17 @@ type Parser struct {
 	s          *Scanner
 	pBal       int // parentheses balance starts with 0
 	lineNo     int // >= 0
+	offset     int // byte offset where scanning continues
 	sourceName string
+	documentID int64 // unique per Parser, see DocumentID
+	recovery   bool  // see SetRecovery
+	dedup      bool  // see SetDedup
+
+	lr     *limitedReader  // counts the bytes read by s
+	limits Limits          // see SetLimits
+	ctx    context.Context // see SetContext
+	tokens int64           // number of tokens read from s
+	err    *PErr           // see Err
 
 	// currentLineHead is the line from beginning to, including, the literal starting at colNo
 	currentLineHead string
@@ -32,7 +44,30 @@ type Parser struct {
 // sourceName identifies what is parsed.
 // The sourceName is shown in error messages. It is never interpreted and must not fulfil any format. Probably, you provide a filename here.
 func NewParser(r io.Reader, sourceName string) *Parser {
-	return &Parser{s: NewScanner(r), sourceName: sourceName, lineNo: 0} // lineNo internally starts with 0
+	lr := &limitedReader{r: r}
+	return &Parser{
+		s:          NewScanner(lr),
+		lr:         lr,
+		sourceName: sourceName,
+		documentID: NewDocumentID(),
+		lineNo:     0, // lineNo internally starts with 0
+	}
+}
+
+// documentCount provides the DocumentID of each new Parser.
+var documentCount int64
+
+// NewDocumentID returns a new, never 0 document ID. Each Parser gets its ID from here.
+// Readers for other formats than OWL-Functional use it to scope the node IDs of anonymous individuals, too.
+func NewDocumentID() int64 {
+	return atomic.AddInt64(&documentCount, 1)
+}
+
+// DocumentID identifies the document which this Parser reads. Each Parser gets its own ID, which is never 0.
+// Node IDs of anonymous individuals, like _:a1, are local to a document. The DocumentID tells them apart
+// when multiple documents use the same node ID.
+func (p *Parser) DocumentID() int64 {
+	return p.documentID
 }
 
 // forwardPos updates colNo and lineNo and currentLineHead,
@@ -54,6 +89,8 @@ func (p *Parser) Scan() (tok Token, lit string, pos ParserPosition) {
 	if p.buf.n != 0 {
 		p.buf.n = 0
 		p.forwardPos(p.buf.tok, p.buf.lit)
+		p.offset = p.s.Offset()
+		p.balance(p.buf.tok)
 		if TokenLog {
 			log.Println("Re-read", DescribeToklit(p.buf.tok, p.buf.lit), "after", p.buf.pos)
 		}
@@ -63,19 +100,25 @@ func (p *Parser) Scan() (tok Token, lit string, pos ParserPosition) {
 	// pos is what we return and buffer. It is where we are before(!) reading the next literal.
 	pos = p.Pos()
 
-	// read the next token from the scanner.
-	tok, lit = p.s.scan()
+	// read the next token from the scanner, unless the parser was stopped (see Err).
+	if p.err == nil {
+		p.checkLimits(pos)
+	}
+	if p.err == nil {
+		tok, lit = p.s.scan()
+		p.checkScanned(tok, pos)
+	}
+	if p.err != nil {
+		tok, lit = EOF, ""
+	}
 	p.forwardPos(tok, lit)
+	p.offset = p.s.Offset()
 
 	// Save it to the buffer in case we unscan later.
 	p.buf.tok, p.buf.lit = tok, lit
 	p.buf.pos = pos
 
-	if tok == B1 {
-		p.pBal++
-	} else if tok == B2 {
-		p.pBal--
-	}
+	p.balance(tok)
 
 	if TokenLog {
 		pos := p.Pos()
@@ -85,6 +128,73 @@ func (p *Parser) Scan() (tok Token, lit string, pos ParserPosition) {
 	return
 }
 
+// SetRecovery switches the recovery mode on or off. It is off by default.
+// In recovery mode, the parser does not stop at an erroneous axiom, but skips it and continues with the next one.
+// Parsing then returns all errors as PErrs.
+func (p *Parser) SetRecovery(on bool) {
+	p.recovery = on
+}
+
+// Recovery is true in recovery mode, see SetRecovery.
+func (p *Parser) Recovery() bool {
+	return p.recovery
+}
+
+// SetDedup switches the duplicate elimination on or off. It is off by default.
+// With duplicate elimination, structurally equal operands are removed from the set-valued expressions, e.g. ObjectUnionOf(:A :B :A) is parsed as ObjectUnionOf(:A :B).
+// An intersection or union with a single remaining operand, like ObjectUnionOf(:A :A), is parsed as that operand.
+func (p *Parser) SetDedup(on bool) {
+	p.dedup = on
+}
+
+// Dedup is true if duplicate elimination is on, see SetDedup.
+func (p *Parser) Dedup() bool {
+	return p.dedup
+}
+
+// SkipTo skips tokens until the parentheses balance is back at pBal, to resynchronise after an error.
+// If PBal() > pBal, tokens are read up to and including the closing parenthesis which gets back to pBal.
+// If PBal() == pBal, the next token is skipped, together with its parenthesized arguments, if there are any.
+// The exception is when the last read token was the closing parenthesis which got back to pBal; then nothing is skipped.
+// An error is returned if EOF comes first.
+func (p *Parser) SkipTo(pBal int) (err error) {
+	if p.pBal < pBal || (p.pBal == pBal && p.buf.n == 0 && p.buf.tok == B2) {
+		return
+	}
+	if p.pBal == pBal {
+		tok, _, pos := p.ScanIgnoreWSAndComment()
+		if tok == EOF {
+			return pos.ErrorfKind(ErrUnexpectedEOF, "unexpected EOF while skipping")
+		}
+		if tok == B2 {
+			// don't skip beyond the enclosing expression
+			p.Unscan()
+			return
+		}
+		tok, _, _ = p.ScanIgnoreWSAndComment()
+		if tok != B1 {
+			p.Unscan()
+			return
+		}
+	}
+	for p.pBal > pBal {
+		tok, _, pos := p.Scan()
+		if tok == EOF {
+			return pos.ErrorfKind(ErrUnexpectedEOF, "unexpected EOF while skipping, missing %d closing parentheses", p.pBal-pBal)
+		}
+	}
+	return
+}
+
+// balance updates pBal after tok was read.
+func (p *Parser) balance(tok Token) {
+	if tok == B1 {
+		p.pBal++
+	} else if tok == B2 {
+		p.pBal--
+	}
+}
+
 // unscan pushes the previously read token back onto the buffer.
 func (p *Parser) Unscan() {
 	p.buf.n = 1
@@ -96,6 +206,7 @@ func (p *Parser) Unscan() {
 	}
 
 	p.lineNo = p.buf.pos.lineNo
+	p.offset = p.buf.pos.offset
 	p.currentLineHead = p.buf.pos.currentLineHead
 
 	if TokenLog {
@@ -130,7 +241,9 @@ func (p *Parser) ConsumeTokens(extoks ...Token) (err error) {
 	for _, extok := range extoks {
 		tok, lit, pos := p.ScanIgnoreWSAndComment()
 		if tok != extok {
-			return pos.Errorf("expected token \"%v\", found %v", Tokenname(extok), lit)
+			perr := pos.ErrorfKind(unexpectedKind(tok), "expected token \"%v\", found %v", Tokenname(extok), lit).(*PErr)
+			perr.Expected = []string{Tokenname(extok)}
+			return perr
 		}
 	}
 	return
@@ -142,5 +255,5 @@ func FmtPrefixedName(prefix, name string) string {
 
 // Pos is the parsing position in the file where scanning will continue.
 func (p *Parser) Pos() ParserPosition {
-	return ParserPosition{lineNo: p.lineNo, currentLineHead: p.currentLineHead, sourceName: &p.sourceName}
+	return ParserPosition{lineNo: p.lineNo, offset: p.offset, currentLineHead: p.currentLineHead, sourceName: &p.sourceName}
 }
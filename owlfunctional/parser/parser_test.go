@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -113,3 +114,79 @@ EquivalentClasses(:HighCaloriePizza) # shortened
 		t.Fatal(p.PBal())
 	}
 }
+
+func TestSkipTo(t *testing.T) {
+	p := NewParser(strings.NewReader(`A(B(C) D) E(F) G H`), "Testdata")
+
+	// inside of A(B(, skip to the end of A(...)
+	if err := p.ConsumeTokens(IDENT, B1, IDENT, B1); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.SkipTo(0); err != nil || p.PBal() != 0 {
+		t.Fatal(err, p.PBal())
+	}
+	// nothing to skip directly after the closing parenthesis
+	if err := p.SkipTo(0); err != nil {
+		t.Fatal(err)
+	}
+	// skip E(F), as after an error at the unscanned E
+	p.ScanIgnoreWSAndComment()
+	p.Unscan()
+	if err := p.SkipTo(0); err != nil {
+		t.Fatal(err)
+	}
+	// skip G, without arguments
+	p.ScanIgnoreWSAndComment()
+	p.Unscan()
+	if err := p.SkipTo(0); err != nil {
+		t.Fatal(err)
+	}
+	if tok, lit, _ := p.ScanIgnoreWSAndComment(); tok != IDENT || lit != "H" {
+		t.Fatal(Tokenname(tok), lit)
+	}
+	if err := p.SkipTo(0); err == nil {
+		t.Fatal("expected EOF error")
+	}
+}
+
+func TestOffset(t *testing.T) {
+	p := NewParser(strings.NewReader("Ä(\"öü\"\n\tB)"), "Testdata")
+
+	// offsets are in bytes, where Ä, ö and ü have 2 bytes each
+	for _, expected := range []int{0, 2, 3, 11} {
+		_, _, pos := p.ScanIgnoreWSAndComment()
+		if pos.Offset() != expected {
+			t.Fatal(expected, pos.Offset())
+		}
+	}
+	p.Unscan()
+	if pos := p.Pos(); pos.Offset() != 11 {
+		t.Fatal(pos.Offset())
+	}
+	_, _, pos := p.ScanIgnoreWSAndComment()
+	if after := p.Pos(); pos.Offset() != 11 || after.Offset() != 12 {
+		t.Fatal(pos.Offset(), after.Offset())
+	}
+}
+
+func TestErrorKind(t *testing.T) {
+	p := NewParser(strings.NewReader(`A`), "Testdata")
+	err := p.ConsumeTokens(B1)
+	if !errors.Is(err, ErrUnexpectedToken) || errors.Is(err, ErrUnexpectedEOF) {
+		t.Fatal(err)
+	}
+	if perr := err.(*PErr); len(perr.Expected) != 1 || perr.Expected[0] != Tokenname(B1) {
+		t.Fatal(perr.Expected)
+	}
+	err = p.ConsumeTokens(B1)
+	if !errors.Is(err, ErrUnexpectedEOF) {
+		t.Fatal(err)
+	}
+
+	pos := p.Pos()
+	cause := errors.New("disk full")
+	err = pos.EnrichErrorMsg(cause, "storing")
+	if !errors.Is(err, cause) || !errors.Is(err, ErrOther) {
+		t.Fatal(err)
+	}
+}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"unicode/utf8"
@@ -9,6 +10,7 @@ import (
 // ParserPosition is a Snapshot of the parsing position in a file
 type ParserPosition struct {
 	lineNo          int     // >= 0
+	offset          int     // byte offset from the start of the source, >= 0
 	currentLineHead string  // currentLineHead is similar to Parser.currentLineHead
 	sourceName      *string // this is the sourceName attribute of the parser. sourceName tells the user what is parsed, e.g. a filename.
 }
@@ -34,6 +36,12 @@ func (p *ParserPosition) LineNo1() int {
 	return p.lineNo + 1
 }
 
+// Offset is the byte offset from the start of the source, starting with 0.
+// Unlike ColNo1, which counts runes, it can be used to seek in the source.
+func (p *ParserPosition) Offset() int {
+	return p.offset
+}
+
 // GetCurrentLineHead is the line belonging to lineNo, until -and including- the
 // literal starting at the current column.
 func (p *ParserPosition) GetCurrentLineHead() string {
@@ -45,21 +53,43 @@ func (p *ParserPosition) SourceName() string {
 	return *p.sourceName
 }
 
+// ErrorfUnexpectedToken returns a PErr of kind ErrUnexpectedToken, or ErrUnexpectedEOF if tok is EOF.
+// need describes what was expected instead, and becomes PErr.Expected.
 func (pos *ParserPosition) ErrorfUnexpectedToken(tok Token, lit string, need string) error {
-	return pos.Errorf("unexpected %v(literal=%v), need %v", Tokenname(tok), lit, need)
+	return pos.ErrorfExpected(tok, need, "unexpected %v(literal=%v), need %v", Tokenname(tok), lit, need)
+}
+
+// ErrorfExpected is like ErrorfUnexpectedToken, with a custom message.
+func (pos *ParserPosition) ErrorfExpected(tok Token, need string, msg string, fmtargs ...interface{}) error {
+	perr := pos.ErrorfKind(unexpectedKind(tok), msg, fmtargs...).(*PErr)
+	perr.Expected = []string{need}
+	return perr
 }
 
 func (pos *ParserPosition) Errorf(msg string, fmtargs ...interface{}) error {
 	return pos.EnsurePErr(fmt.Errorf(msg, fmtargs...))
 }
 
+// ErrorfKind is like Errorf, with the error kind set.
+func (pos *ParserPosition) ErrorfKind(kind ErrorKind, msg string, fmtargs ...interface{}) error {
+	perr := pos.EnsurePErr(fmt.Errorf(msg, fmtargs...))
+	perr.Kind = kind
+	return perr
+}
+
 // EnsurePErr returns err, if this is already a PErr.
 // Otherwise, creates a new PErr this this Position, and the message string from err.
+// The new PErr wraps err, and takes over its kind, if err wraps an ErrorKind (see ErrorKind).
 func (pos *ParserPosition) EnsurePErr(err error) *PErr {
 	if perr, ok := err.(*PErr); ok {
 		return perr
 	}
-	return &PErr{Msg: err.Error(), AfterPos: *pos}
+	perr := &PErr{Msg: err.Error(), AfterPos: *pos, Err: err}
+	var kind ErrorKind
+	if errors.As(err, &kind) {
+		perr.Kind = kind
+	}
+	return perr
 }
 
 // EnrichErrorMsg returns err or a new PErr
@@ -0,0 +1,383 @@
+// structural compares class expressions, data ranges and axioms by their structure, as in the OWL 2 structural specification.
+// Two values are equal if they are built the same way from the same entities, even when they are different pointers.
+// Set-valued arguments, like the operands of ObjectUnionOf or the classes of EquivalentClasses, are compared as unordered sets,
+// so that ObjectUnionOf(A B A) equals ObjectUnionOf(B A).
+package structural
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/assertions"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/properties"
+)
+
+// Equal is true if a and b are structurally equal. a and b can be class expressions, data ranges,
+// object property expressions, data properties, or axioms (as values, like axioms.SubClassOf).
+// Values of unknown types are equal only if they are the same pointer, or equal non-pointer values.
+func Equal(a, b interface{}) bool {
+	return Key(a) == Key(b)
+}
+
+// Hash returns a hash of x, which is the same for structurally equal values, see Equal.
+func Hash(x interface{}) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(Key(x)))
+	return h.Sum64()
+}
+
+// Key returns a canonical string of x, in the style of OWL-Functional syntax with full IRIs.
+// Structurally equal values have the same key, so that Key can be used for maps and sorting.
+// Set-valued arguments are sorted by their keys, and duplicates are removed.
+func Key(x interface{}) string {
+	switch x := x.(type) {
+	case nil:
+		return "nil"
+
+	// named entities
+	case *decl.ClassDecl:
+		return iri(x.IRI)
+	case *classexpression.OWLThing:
+		return iri(builtindatatypes.PRE_OWL + "Thing")
+	case *classexpression.OWLNothing:
+		return iri(builtindatatypes.PRE_OWL + "Nothing")
+	case *decl.ObjectPropertyDecl:
+		return iri(x.IRI)
+	case *properties.OWLTopObjectProperty:
+		return iri(builtindatatypes.PRE_OWL + "topObjectProperty")
+	case *properties.OWLBottomObjectProperty:
+		return iri(builtindatatypes.PRE_OWL + "bottomObjectProperty")
+	case *decl.DataPropertyDecl:
+		return iri(x.IRI)
+	case *properties.OWLTopDataProperty:
+		return iri(builtindatatypes.PRE_OWL + "topDataProperty")
+	case *properties.OWLBottomDataProperty:
+		return iri(builtindatatypes.PRE_OWL + "bottomDataProperty")
+	case *decl.DatatypeDecl:
+		return iri(x.IRI)
+	case *facets.BuiltinDatatype:
+		return iri(x.DatatypeIRI)
+	case *facets.CustomNamedDatatype:
+		// the definition is not part of the identity of a named datatype
+		return iri(x.DatatypeIRI)
+	case *decl.AnnotationPropertyDecl:
+		return iri(x.IRI)
+	case string:
+		// annotation properties which are not declared, see meta.AnnotationProperty
+		return iri(x)
+	case individual.Individual:
+		if x.IsAnonymous() {
+			return fmt.Sprintf("%v@%d", x.NodeID, x.DocumentID)
+		}
+		return iri(x.Name)
+	case literal.OWLLiteral:
+		res := strconv.Quote(x.Value)
+		if x.LangTag != "" {
+			res += "@" + x.LangTag
+		}
+		if x.Literaltype != "" {
+			res += "^^" + iri(x.Literaltype)
+		}
+		return res
+
+	// object property expressions
+	case *properties.ObjectInverseOf:
+		return fn("ObjectInverseOf", iri(x.PN))
+
+	// class expressions
+	case *classexpression.ObjectIntersectionOf:
+		return fn("ObjectIntersectionOf", set(classExpressions(x.Cs)))
+	case *classexpression.ObjectUnionOf:
+		return fn("ObjectUnionOf", set(classExpressions(x.Cs)))
+	case *classexpression.ObjectComplementOf:
+		return fn("ObjectComplementOf", Key(x.C))
+	case *classexpression.ObjectOneOf:
+		return fn("ObjectOneOf", set(individuals(x.As)))
+	case *classexpression.ObjectSomeValuesFrom:
+		return fn("ObjectSomeValuesFrom", Key(x.P), Key(x.C))
+	case *classexpression.ObjectAllValuesFrom:
+		return fn("ObjectAllValuesFrom", Key(x.P), Key(x.C))
+	case *classexpression.ObjectHasValue:
+		return fn("ObjectHasValue", Key(x.P), Key(x.A))
+	case *classexpression.ObjectHasSelf:
+		return fn("ObjectHasSelf", Key(x.P))
+	case *classexpression.ObjectMinCardinality:
+		return fn("ObjectMinCardinality", strconv.Itoa(x.N), Key(x.P))
+	case *classexpression.ObjectMaxCardinality:
+		return fn("ObjectMaxCardinality", strconv.Itoa(x.N), Key(x.P))
+	case *classexpression.ObjectExactCardinality:
+		return fn("ObjectExactCardinality", strconv.Itoa(x.N), Key(x.P))
+	case *classexpression.ObjectQualifiedMinCardinality:
+		return fn("ObjectMinCardinality", strconv.Itoa(x.N), Key(x.P), Key(x.C))
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		return fn("ObjectMaxCardinality", strconv.Itoa(x.N), Key(x.P), Key(x.C))
+	case *classexpression.ObjectQualifiedExactCardinality:
+		return fn("ObjectExactCardinality", strconv.Itoa(x.N), Key(x.P), Key(x.C))
+	case *classexpression.DataSomeValuesFrom:
+		return fn("DataSomeValuesFrom", Key(x.R), Key(x.D))
+	case *classexpression.DataAllValuesFrom:
+		return fn("DataAllValuesFrom", Key(x.R), Key(x.D))
+	case *classexpression.DataHasValue:
+		return fn("DataHasValue", Key(x.R), Key(x.V))
+	case *classexpression.DataMinCardinality:
+		return fn("DataMinCardinality", strconv.Itoa(x.N), Key(x.R))
+	case *classexpression.DataMaxCardinality:
+		return fn("DataMaxCardinality", strconv.Itoa(x.N), Key(x.R))
+	case *classexpression.DataExactCardinality:
+		return fn("DataExactCardinality", strconv.Itoa(x.N), Key(x.R))
+	case *classexpression.DataQualifiedMinCardinality:
+		return fn("DataMinCardinality", strconv.Itoa(x.N), Key(x.R), Key(x.D))
+	case *classexpression.DataQualifiedMaxCardinality:
+		return fn("DataMaxCardinality", strconv.Itoa(x.N), Key(x.R), Key(x.D))
+	case *classexpression.DataQualifiedExactCardinality:
+		return fn("DataExactCardinality", strconv.Itoa(x.N), Key(x.R), Key(x.D))
+
+	// data ranges
+	case *facets.DatatypeRestriction:
+		pairs := make([]string, len(x.FVPairs))
+		for i, pair := range x.FVPairs {
+			pairs[i] = iri(pair.F.IRI()) + " " + Key(pair.V)
+		}
+		return fn("DatatypeRestriction", Key(x.DN), set(pairs))
+	case *dataranges.DataComplementOf:
+		return fn("DataComplementOf", Key(x.D))
+	case *dataranges.DataIntersectionOf:
+		return fn("DataIntersectionOf", set(dataRanges(x.Ds)))
+	case *dataranges.DataUnionOf:
+		return fn("DataUnionOf", set(dataRanges(x.Ds)))
+	case *dataranges.DataOneOf:
+		return fn("DataOneOf", set(literals(x.Vs)))
+
+	// axioms
+	case axioms.DatatypeDefinition:
+		return fn("DatatypeDefinition", Key(x.DN), Key(x.D))
+	case axioms.SubClassOf:
+		return fn("SubClassOf", Key(x.C1), Key(x.C2))
+	case axioms.EquivalentClasses:
+		return fn("EquivalentClasses", set(classExpressions(x.EquivalentClasses)))
+	case axioms.DisjointClasses:
+		return fn("DisjointClasses", set(classExpressions(x.DisjointClasses)))
+	case axioms.DisjointUnion:
+		return fn("DisjointUnion", Key(x.CN), set(classExpressions(x.DisjointClasses)))
+	case axioms.SubObjectPropertyOf:
+		return fn("SubObjectPropertyOf", Key(x.P1), Key(x.P2))
+	case axioms.SubObjectPropertyChainOf:
+		return fn("SubObjectPropertyOf", fn("ObjectPropertyChain", objectPropertyExpressions(x.Chain)...), Key(x.P))
+	case axioms.EquivalentObjectProperties:
+		return fn("EquivalentObjectProperties", set(objectPropertyExpressions(x.Ps)))
+	case axioms.DisjointObjectProperties:
+		return fn("DisjointObjectProperties", set(objectPropertyExpressions(x.Ps)))
+	case axioms.InverseObjectProperties:
+		return fn("InverseObjectProperties", Key(x.P1), Key(x.P2))
+	case axioms.ObjectPropertyDomain:
+		return fn("ObjectPropertyDomain", Key(x.P), Key(x.C))
+	case axioms.ObjectPropertyRange:
+		return fn("ObjectPropertyRange", Key(x.P), Key(x.C))
+	case axioms.FunctionalObjectProperty:
+		return fn("FunctionalObjectProperty", Key(x.P))
+	case axioms.InverseFunctionalObjectProperty:
+		return fn("InverseFunctionalObjectProperty", Key(x.P))
+	case axioms.ReflexiveObjectProperty:
+		return fn("ReflexiveObjectProperty", Key(x.P))
+	case axioms.IrreflexiveObjectProperty:
+		return fn("IrreflexiveObjectProperty", Key(x.P))
+	case axioms.SymmetricObjectProperty:
+		return fn("SymmetricObjectProperty", Key(x.P))
+	case axioms.AsymmetricObjectProperty:
+		return fn("AsymmetricObjectProperty", Key(x.P))
+	case axioms.TransitiveObjectProperty:
+		return fn("TransitiveObjectProperty", Key(x.P))
+	case axioms.SubDataPropertyOf:
+		return fn("SubDataPropertyOf", Key(x.P1), Key(x.P2))
+	case axioms.EquivalentDataProperties:
+		return fn("EquivalentDataProperties", set(dataProperties(x.Rs)))
+	case axioms.DisjointDataProperties:
+		return fn("DisjointDataProperties", set(dataProperties(x.Rs)))
+	case axioms.DataPropertyDomain:
+		return fn("DataPropertyDomain", Key(x.R), Key(x.C))
+	case axioms.DataPropertyRange:
+		return fn("DataPropertyRange", Key(x.R), Key(x.D))
+	case axioms.FunctionalDataProperty:
+		return fn("FunctionalDataProperty", Key(x.R))
+	case axioms.HasKey:
+		return fn("HasKey", Key(x.C), fn("", set(objectPropertyExpressions(x.Ps))), fn("", set(dataProperties(x.Rs))))
+	case axioms.SameIndividual:
+		return fn("SameIndividual", set(individuals(x.As)))
+	case axioms.DifferentIndividuals:
+		return fn("DifferentIndividuals", set(individuals(x.As)))
+	case axioms.ClassAssertion:
+		return fn("ClassAssertion", Key(x.C), Key(x.A))
+	case assertions.ObjectPropertyAssertion:
+		return fn("ObjectPropertyAssertion", Key(x.P), Key(x.A1), Key(x.A2))
+	case assertions.NegativeObjectPropertyAssertion:
+		return fn("NegativeObjectPropertyAssertion", Key(x.P), Key(x.A1), Key(x.A2))
+	case axioms.DataPropertyAssertion:
+		return fn("DataPropertyAssertion", Key(x.R), Key(x.A), Key(x.V))
+	case assertions.NegativeDataPropertyAssertion:
+		return fn("NegativeDataPropertyAssertion", Key(x.R), Key(x.A), Key(x.V))
+	case annotations.AnnotationAssertion:
+		return fn("AnnotationAssertion", Key(x.A), annotationValue(x.S, x.DocumentID), annotationValue(x.T, x.DocumentID))
+	case annotations.SubAnnotationPropertyOf:
+		return fn("SubAnnotationPropertyOf", Key(x.A1), Key(x.A2))
+	case annotations.AnnotationPropertyDomain:
+		return fn("AnnotationPropertyDomain", Key(x.A), strconv.Quote(x.U))
+	case annotations.AnnotationPropertyRange:
+		return fn("AnnotationPropertyRange", Key(x.A), strconv.Quote(x.U))
+	case meta.Annotation:
+		return fn("Annotation", Key(x.A()), annotationValue(x.T(), x.DocumentID()))
+	}
+
+	// unknown types
+	if reflect.ValueOf(x).Kind() == reflect.Ptr {
+		return fmt.Sprintf("?%T@%p", x, x)
+	}
+	return fmt.Sprintf("?%#v", x)
+}
+
+// Dedup returns the set elements of xs in their original order, without structurally equal duplicates, see Equal.
+// xs itself is not modified.
+func Dedup(xs []meta.ClassExpression) []meta.ClassExpression {
+	res := make([]meta.ClassExpression, 0, len(xs))
+	seen := map[string]bool{}
+	for _, x := range xs {
+		key := Key(x)
+		if !seen[key] {
+			seen[key] = true
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+// DedupDataRanges is like Dedup, for data ranges.
+func DedupDataRanges(xs []meta.DataRange) []meta.DataRange {
+	res := make([]meta.DataRange, 0, len(xs))
+	seen := map[string]bool{}
+	for _, x := range xs {
+		key := Key(x)
+		if !seen[key] {
+			seen[key] = true
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+// DedupIndividuals is like Dedup, for individuals.
+func DedupIndividuals(xs []individual.Individual) []individual.Individual {
+	res := make([]individual.Individual, 0, len(xs))
+	seen := map[string]bool{}
+	for _, x := range xs {
+		key := Key(x)
+		if !seen[key] {
+			seen[key] = true
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+// DedupLiterals is like Dedup, for literals.
+func DedupLiterals(xs []literal.OWLLiteral) []literal.OWLLiteral {
+	res := make([]literal.OWLLiteral, 0, len(xs))
+	seen := map[string]bool{}
+	for _, x := range xs {
+		key := Key(x)
+		if !seen[key] {
+			seen[key] = true
+			res = append(res, x)
+		}
+	}
+	return res
+}
+
+func iri(s string) string {
+	return "<" + s + ">"
+}
+
+// fn formats a constructor with its arguments, like "ObjectUnionOf(<a> <b>)".
+func fn(name string, args ...string) string {
+	return name + "(" + strings.Join(args, " ") + ")"
+}
+
+// set sorts the keys and removes duplicates, and joins them.
+func set(keys []string) string {
+	sort.Strings(keys)
+	res := keys[:0]
+	for i, key := range keys {
+		if i == 0 || key != keys[i-1] {
+			res = append(res, key)
+		}
+	}
+	return strings.Join(res, " ")
+}
+
+func classExpressions(Cs []meta.ClassExpression) []string {
+	keys := make([]string, len(Cs))
+	for i, C := range Cs {
+		keys[i] = Key(C)
+	}
+	return keys
+}
+
+func dataRanges(Ds []meta.DataRange) []string {
+	keys := make([]string, len(Ds))
+	for i, D := range Ds {
+		keys[i] = Key(D)
+	}
+	return keys
+}
+
+func objectPropertyExpressions(Ps []meta.ObjectPropertyExpression) []string {
+	keys := make([]string, len(Ps))
+	for i, P := range Ps {
+		keys[i] = Key(P)
+	}
+	return keys
+}
+
+func dataProperties(Rs []meta.DataProperty) []string {
+	keys := make([]string, len(Rs))
+	for i, R := range Rs {
+		keys[i] = Key(R)
+	}
+	return keys
+}
+
+// annotationValue is the key of an annotation subject or value. A node ID is scoped by its document, like an anonymous individual.
+func annotationValue(value string, documentID int64) string {
+	if strings.HasPrefix(value, "_:") {
+		return Key(individual.NewAnonymous(value, documentID))
+	}
+	return strconv.Quote(value)
+}
+
+func individuals(as []individual.Individual) []string {
+	keys := make([]string, len(as))
+	for i, a := range as {
+		keys[i] = Key(a)
+	}
+	return keys
+}
+
+func literals(ls []literal.OWLLiteral) []string {
+	keys := make([]string, len(ls))
+	for i, l := range ls {
+		keys[i] = Key(l)
+	}
+	return keys
+}
@@ -0,0 +1,96 @@
+package structural_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
+	. "github.com/shful/gofp/owlfunctional/structural"
+	"github.com/shful/gofp/storedefaults"
+)
+
+const ontology = `Prefix(:=<http://example.com/pizza#>)
+Prefix(xsd:=<http://www.w3.org/2001/XMLSchema#>)
+
+Ontology(<http://example.com/pizza>
+	SubClassOf(:A ObjectUnionOf(:Person :Animal :Animal))
+	SubClassOf(:A ObjectUnionOf(:Animal :Person))
+	SubClassOf(:A ObjectIntersectionOf(:Animal :Person))
+	SubClassOf(:B ObjectSomeValuesFrom(:p DataSomeValuesFrom(:d DataOneOf("1" 2))))
+	SubClassOf(:B ObjectSomeValuesFrom(:p DataSomeValuesFrom(:d DataOneOf(2 "1" 2))))
+	SubClassOf(:B ObjectSomeValuesFrom(:p DataSomeValuesFrom(:d DataOneOf(1 "2"))))
+	EquivalentClasses(:A :B ObjectOneOf(:x :y))
+	EquivalentClasses(ObjectOneOf(:y :x) :B :A)
+)
+`
+
+func TestEqual(t *testing.T) {
+	// keep the duplicates, to compare them
+	k := storedefaults.NewDefaultK()
+	k.ExplicitDecls = false
+	k.AppendOnly = true
+	_, err := gofp.OntologyFromParser(parser.NewParser(strings.NewReader(ontology), "test"), owlfunctional.StoreConfig{AxiomStore: k, Decls: k, DeclStore: k})
+	if err != nil {
+		t.Fatal(err)
+	}
+	subs := k.AllSubClassOfs()
+	for _, c := range []struct {
+		a, b  meta.ClassExpression
+		equal bool
+	}{
+		{subs[0].C2, subs[1].C2, true},
+		{subs[0].C2, subs[2].C2, false},
+		{subs[3].C2, subs[4].C2, true},
+		{subs[3].C2, subs[5].C2, false},
+		{subs[0].C1, subs[1].C1, true},
+		{subs[0].C1, subs[3].C1, false},
+	} {
+		if Equal(c.a, c.b) != c.equal {
+			t.Fatal(Key(c.a), Key(c.b))
+		}
+		if c.equal && Hash(c.a) != Hash(c.b) {
+			t.Fatal(Key(c.a))
+		}
+	}
+	if Equal(subs[0], subs[1]) != true || Equal(subs[0], subs[2]) != false {
+		t.Fatal(Key(subs[0]), Key(subs[2]))
+	}
+
+	eqs := k.AllEquivalentClasses()
+	if !Equal(eqs[0], eqs[1]) {
+		t.Fatal(Key(eqs[0]), Key(eqs[1]))
+	}
+	if Key(subs[1].C2) != "ObjectUnionOf(<http://example.com/pizza#Animal> <http://example.com/pizza#Person>)" {
+		t.Fatal(Key(subs[1].C2))
+	}
+}
+
+func TestEqualPointers(t *testing.T) {
+	A1 := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}
+	A2 := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}
+	if !Equal(&classexpression.ObjectComplementOf{C: A1}, &classexpression.ObjectComplementOf{C: A2}) {
+		t.Fatal("expected equal")
+	}
+	if Equal(axioms.SubClassOf{C1: A1, C2: A2}, axioms.SubClassOf{C1: A1, C2: &classexpression.OWLThing{}}) {
+		t.Fatal("expected not equal")
+	}
+	type unknown struct{ x int }
+	if Equal(&unknown{1}, &unknown{1}) || !Equal(unknown{1}, unknown{1}) {
+		t.Fatal("unknown types")
+	}
+}
+
+func TestDedup(t *testing.T) {
+	A := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}
+	B := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:B"}}
+	res := Dedup([]meta.ClassExpression{A, B, &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}, B})
+	if len(res) != 2 || res[0] != A || res[1] != B {
+		t.Fatal(res)
+	}
+}
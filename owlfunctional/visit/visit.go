@@ -0,0 +1,425 @@
+// visit provides visitors for the parsed OWL structures, so that analyses and transformations
+// need no type switches of their own.
+// There is one visitor interface each for class expressions, data ranges, object property expressions, data properties and axioms,
+// with one method per type. Adding a type to gofp adds a method to the interface, so that the compiler finds each visitor to update.
+// Embed Base into a visitor struct, to implement only the methods of interest.
+// Walk traverses an axiom or expression depth-first, with all nested expressions.
+package visit
+
+import (
+	"fmt"
+
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/assertions"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/properties"
+)
+
+// ClassExpressionVisitor has one method for each class expression type.
+type ClassExpressionVisitor interface {
+	VisitClassDecl(C *decl.ClassDecl)
+	VisitOWLThing(C *classexpression.OWLThing)
+	VisitOWLNothing(C *classexpression.OWLNothing)
+	VisitObjectIntersectionOf(C *classexpression.ObjectIntersectionOf)
+	VisitObjectUnionOf(C *classexpression.ObjectUnionOf)
+	VisitObjectComplementOf(C *classexpression.ObjectComplementOf)
+	VisitObjectOneOf(C *classexpression.ObjectOneOf)
+	VisitObjectSomeValuesFrom(C *classexpression.ObjectSomeValuesFrom)
+	VisitObjectAllValuesFrom(C *classexpression.ObjectAllValuesFrom)
+	VisitObjectHasValue(C *classexpression.ObjectHasValue)
+	VisitObjectHasSelf(C *classexpression.ObjectHasSelf)
+	VisitObjectMinCardinality(C *classexpression.ObjectMinCardinality)
+	VisitObjectMaxCardinality(C *classexpression.ObjectMaxCardinality)
+	VisitObjectExactCardinality(C *classexpression.ObjectExactCardinality)
+	VisitObjectQualifiedMinCardinality(C *classexpression.ObjectQualifiedMinCardinality)
+	VisitObjectQualifiedMaxCardinality(C *classexpression.ObjectQualifiedMaxCardinality)
+	VisitObjectQualifiedExactCardinality(C *classexpression.ObjectQualifiedExactCardinality)
+	VisitDataSomeValuesFrom(C *classexpression.DataSomeValuesFrom)
+	VisitDataAllValuesFrom(C *classexpression.DataAllValuesFrom)
+	VisitDataHasValue(C *classexpression.DataHasValue)
+	VisitDataMinCardinality(C *classexpression.DataMinCardinality)
+	VisitDataMaxCardinality(C *classexpression.DataMaxCardinality)
+	VisitDataExactCardinality(C *classexpression.DataExactCardinality)
+	VisitDataQualifiedMinCardinality(C *classexpression.DataQualifiedMinCardinality)
+	VisitDataQualifiedMaxCardinality(C *classexpression.DataQualifiedMaxCardinality)
+	VisitDataQualifiedExactCardinality(C *classexpression.DataQualifiedExactCardinality)
+}
+
+// DataRangeVisitor has one method for each data range type.
+type DataRangeVisitor interface {
+	VisitBuiltinDatatype(D *facets.BuiltinDatatype)
+	VisitCustomNamedDatatype(D *facets.CustomNamedDatatype)
+	VisitDatatypeDecl(D *decl.DatatypeDecl)
+	VisitDatatypeRestriction(D *facets.DatatypeRestriction)
+	VisitDataComplementOf(D *dataranges.DataComplementOf)
+	VisitDataIntersectionOf(D *dataranges.DataIntersectionOf)
+	VisitDataUnionOf(D *dataranges.DataUnionOf)
+	VisitDataOneOf(D *dataranges.DataOneOf)
+}
+
+// ObjectPropertyExpressionVisitor has one method for each object property expression type.
+type ObjectPropertyExpressionVisitor interface {
+	VisitObjectPropertyDecl(P *decl.ObjectPropertyDecl)
+	VisitObjectInverseOf(P *properties.ObjectInverseOf)
+	VisitOWLTopObjectProperty(P *properties.OWLTopObjectProperty)
+	VisitOWLBottomObjectProperty(P *properties.OWLBottomObjectProperty)
+}
+
+// DataPropertyVisitor has one method for each data property type.
+type DataPropertyVisitor interface {
+	VisitDataPropertyDecl(R *decl.DataPropertyDecl)
+	VisitOWLTopDataProperty(R *properties.OWLTopDataProperty)
+	VisitOWLBottomDataProperty(R *properties.OWLBottomDataProperty)
+}
+
+// AxiomVisitor has one method for each axiom type.
+// Axioms are values, as returned by the All-methods of storedefaults.K. The property characteristics,
+// which K returns as bare properties, are wrapped into types like axioms.FunctionalObjectProperty.
+type AxiomVisitor interface {
+	VisitDatatypeDefinition(x axioms.DatatypeDefinition)
+	VisitSubClassOf(x axioms.SubClassOf)
+	VisitEquivalentClasses(x axioms.EquivalentClasses)
+	VisitDisjointClasses(x axioms.DisjointClasses)
+	VisitDisjointUnion(x axioms.DisjointUnion)
+	VisitSubObjectPropertyOf(x axioms.SubObjectPropertyOf)
+	VisitSubObjectPropertyChainOf(x axioms.SubObjectPropertyChainOf)
+	VisitEquivalentObjectProperties(x axioms.EquivalentObjectProperties)
+	VisitDisjointObjectProperties(x axioms.DisjointObjectProperties)
+	VisitInverseObjectProperties(x axioms.InverseObjectProperties)
+	VisitObjectPropertyDomain(x axioms.ObjectPropertyDomain)
+	VisitObjectPropertyRange(x axioms.ObjectPropertyRange)
+	VisitFunctionalObjectProperty(x axioms.FunctionalObjectProperty)
+	VisitInverseFunctionalObjectProperty(x axioms.InverseFunctionalObjectProperty)
+	VisitReflexiveObjectProperty(x axioms.ReflexiveObjectProperty)
+	VisitIrreflexiveObjectProperty(x axioms.IrreflexiveObjectProperty)
+	VisitSymmetricObjectProperty(x axioms.SymmetricObjectProperty)
+	VisitAsymmetricObjectProperty(x axioms.AsymmetricObjectProperty)
+	VisitTransitiveObjectProperty(x axioms.TransitiveObjectProperty)
+	VisitSubDataPropertyOf(x axioms.SubDataPropertyOf)
+	VisitEquivalentDataProperties(x axioms.EquivalentDataProperties)
+	VisitDisjointDataProperties(x axioms.DisjointDataProperties)
+	VisitDataPropertyDomain(x axioms.DataPropertyDomain)
+	VisitDataPropertyRange(x axioms.DataPropertyRange)
+	VisitFunctionalDataProperty(x axioms.FunctionalDataProperty)
+	VisitHasKey(x axioms.HasKey)
+	VisitSameIndividual(x axioms.SameIndividual)
+	VisitDifferentIndividuals(x axioms.DifferentIndividuals)
+	VisitClassAssertion(x axioms.ClassAssertion)
+	VisitObjectPropertyAssertion(x assertions.ObjectPropertyAssertion)
+	VisitNegativeObjectPropertyAssertion(x assertions.NegativeObjectPropertyAssertion)
+	VisitDataPropertyAssertion(x axioms.DataPropertyAssertion)
+	VisitNegativeDataPropertyAssertion(x assertions.NegativeDataPropertyAssertion)
+	VisitAnnotationAssertion(x annotations.AnnotationAssertion)
+	VisitSubAnnotationPropertyOf(x annotations.SubAnnotationPropertyOf)
+	VisitAnnotationPropertyDomain(x annotations.AnnotationPropertyDomain)
+	VisitAnnotationPropertyRange(x annotations.AnnotationPropertyRange)
+}
+
+// ClassExpression calls the method of v which belongs to the type of C. Nested expressions are not visited, see Walk for that.
+// An error is returned if C has an unknown type, or is nil.
+func ClassExpression(C meta.ClassExpression, v ClassExpressionVisitor) error {
+	switch C := C.(type) {
+	case *decl.ClassDecl:
+		v.VisitClassDecl(C)
+	case *classexpression.OWLThing:
+		v.VisitOWLThing(C)
+	case *classexpression.OWLNothing:
+		v.VisitOWLNothing(C)
+	case *classexpression.ObjectIntersectionOf:
+		v.VisitObjectIntersectionOf(C)
+	case *classexpression.ObjectUnionOf:
+		v.VisitObjectUnionOf(C)
+	case *classexpression.ObjectComplementOf:
+		v.VisitObjectComplementOf(C)
+	case *classexpression.ObjectOneOf:
+		v.VisitObjectOneOf(C)
+	case *classexpression.ObjectSomeValuesFrom:
+		v.VisitObjectSomeValuesFrom(C)
+	case *classexpression.ObjectAllValuesFrom:
+		v.VisitObjectAllValuesFrom(C)
+	case *classexpression.ObjectHasValue:
+		v.VisitObjectHasValue(C)
+	case *classexpression.ObjectHasSelf:
+		v.VisitObjectHasSelf(C)
+	case *classexpression.ObjectMinCardinality:
+		v.VisitObjectMinCardinality(C)
+	case *classexpression.ObjectMaxCardinality:
+		v.VisitObjectMaxCardinality(C)
+	case *classexpression.ObjectExactCardinality:
+		v.VisitObjectExactCardinality(C)
+	case *classexpression.ObjectQualifiedMinCardinality:
+		v.VisitObjectQualifiedMinCardinality(C)
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		v.VisitObjectQualifiedMaxCardinality(C)
+	case *classexpression.ObjectQualifiedExactCardinality:
+		v.VisitObjectQualifiedExactCardinality(C)
+	case *classexpression.DataSomeValuesFrom:
+		v.VisitDataSomeValuesFrom(C)
+	case *classexpression.DataAllValuesFrom:
+		v.VisitDataAllValuesFrom(C)
+	case *classexpression.DataHasValue:
+		v.VisitDataHasValue(C)
+	case *classexpression.DataMinCardinality:
+		v.VisitDataMinCardinality(C)
+	case *classexpression.DataMaxCardinality:
+		v.VisitDataMaxCardinality(C)
+	case *classexpression.DataExactCardinality:
+		v.VisitDataExactCardinality(C)
+	case *classexpression.DataQualifiedMinCardinality:
+		v.VisitDataQualifiedMinCardinality(C)
+	case *classexpression.DataQualifiedMaxCardinality:
+		v.VisitDataQualifiedMaxCardinality(C)
+	case *classexpression.DataQualifiedExactCardinality:
+		v.VisitDataQualifiedExactCardinality(C)
+	default:
+		return fmt.Errorf("cannot visit class expression of type %T", C)
+	}
+	return nil
+}
+
+// DataRange calls the method of v which belongs to the type of D. Nested expressions are not visited, see Walk for that.
+// An error is returned if D has an unknown type, or is nil.
+func DataRange(D meta.DataRange, v DataRangeVisitor) error {
+	switch D := D.(type) {
+	case *facets.BuiltinDatatype:
+		v.VisitBuiltinDatatype(D)
+	case *facets.CustomNamedDatatype:
+		v.VisitCustomNamedDatatype(D)
+	case *decl.DatatypeDecl:
+		v.VisitDatatypeDecl(D)
+	case *facets.DatatypeRestriction:
+		v.VisitDatatypeRestriction(D)
+	case *dataranges.DataComplementOf:
+		v.VisitDataComplementOf(D)
+	case *dataranges.DataIntersectionOf:
+		v.VisitDataIntersectionOf(D)
+	case *dataranges.DataUnionOf:
+		v.VisitDataUnionOf(D)
+	case *dataranges.DataOneOf:
+		v.VisitDataOneOf(D)
+	default:
+		return fmt.Errorf("cannot visit data range of type %T", D)
+	}
+	return nil
+}
+
+// ObjectPropertyExpression calls the method of v which belongs to the type of P. Nested expressions are not visited, see Walk for that.
+// An error is returned if P has an unknown type, or is nil.
+func ObjectPropertyExpression(P meta.ObjectPropertyExpression, v ObjectPropertyExpressionVisitor) error {
+	switch P := P.(type) {
+	case *decl.ObjectPropertyDecl:
+		v.VisitObjectPropertyDecl(P)
+	case *properties.ObjectInverseOf:
+		v.VisitObjectInverseOf(P)
+	case *properties.OWLTopObjectProperty:
+		v.VisitOWLTopObjectProperty(P)
+	case *properties.OWLBottomObjectProperty:
+		v.VisitOWLBottomObjectProperty(P)
+	default:
+		return fmt.Errorf("cannot visit object property expression of type %T", P)
+	}
+	return nil
+}
+
+// DataProperty calls the method of v which belongs to the type of R. Nested expressions are not visited, see Walk for that.
+// An error is returned if R has an unknown type, or is nil.
+func DataProperty(R meta.DataProperty, v DataPropertyVisitor) error {
+	switch R := R.(type) {
+	case *decl.DataPropertyDecl:
+		v.VisitDataPropertyDecl(R)
+	case *properties.OWLTopDataProperty:
+		v.VisitOWLTopDataProperty(R)
+	case *properties.OWLBottomDataProperty:
+		v.VisitOWLBottomDataProperty(R)
+	default:
+		return fmt.Errorf("cannot visit data property of type %T", R)
+	}
+	return nil
+}
+
+// Axiom calls the method of v which belongs to the type of x. Nested expressions are not visited, see Walk for that.
+// An error is returned if x has an unknown type, or is nil.
+func Axiom(x interface{}, v AxiomVisitor) error {
+	switch x := x.(type) {
+	case axioms.DatatypeDefinition:
+		v.VisitDatatypeDefinition(x)
+	case axioms.SubClassOf:
+		v.VisitSubClassOf(x)
+	case axioms.EquivalentClasses:
+		v.VisitEquivalentClasses(x)
+	case axioms.DisjointClasses:
+		v.VisitDisjointClasses(x)
+	case axioms.DisjointUnion:
+		v.VisitDisjointUnion(x)
+	case axioms.SubObjectPropertyOf:
+		v.VisitSubObjectPropertyOf(x)
+	case axioms.SubObjectPropertyChainOf:
+		v.VisitSubObjectPropertyChainOf(x)
+	case axioms.EquivalentObjectProperties:
+		v.VisitEquivalentObjectProperties(x)
+	case axioms.DisjointObjectProperties:
+		v.VisitDisjointObjectProperties(x)
+	case axioms.InverseObjectProperties:
+		v.VisitInverseObjectProperties(x)
+	case axioms.ObjectPropertyDomain:
+		v.VisitObjectPropertyDomain(x)
+	case axioms.ObjectPropertyRange:
+		v.VisitObjectPropertyRange(x)
+	case axioms.FunctionalObjectProperty:
+		v.VisitFunctionalObjectProperty(x)
+	case axioms.InverseFunctionalObjectProperty:
+		v.VisitInverseFunctionalObjectProperty(x)
+	case axioms.ReflexiveObjectProperty:
+		v.VisitReflexiveObjectProperty(x)
+	case axioms.IrreflexiveObjectProperty:
+		v.VisitIrreflexiveObjectProperty(x)
+	case axioms.SymmetricObjectProperty:
+		v.VisitSymmetricObjectProperty(x)
+	case axioms.AsymmetricObjectProperty:
+		v.VisitAsymmetricObjectProperty(x)
+	case axioms.TransitiveObjectProperty:
+		v.VisitTransitiveObjectProperty(x)
+	case axioms.SubDataPropertyOf:
+		v.VisitSubDataPropertyOf(x)
+	case axioms.EquivalentDataProperties:
+		v.VisitEquivalentDataProperties(x)
+	case axioms.DisjointDataProperties:
+		v.VisitDisjointDataProperties(x)
+	case axioms.DataPropertyDomain:
+		v.VisitDataPropertyDomain(x)
+	case axioms.DataPropertyRange:
+		v.VisitDataPropertyRange(x)
+	case axioms.FunctionalDataProperty:
+		v.VisitFunctionalDataProperty(x)
+	case axioms.HasKey:
+		v.VisitHasKey(x)
+	case axioms.SameIndividual:
+		v.VisitSameIndividual(x)
+	case axioms.DifferentIndividuals:
+		v.VisitDifferentIndividuals(x)
+	case axioms.ClassAssertion:
+		v.VisitClassAssertion(x)
+	case assertions.ObjectPropertyAssertion:
+		v.VisitObjectPropertyAssertion(x)
+	case assertions.NegativeObjectPropertyAssertion:
+		v.VisitNegativeObjectPropertyAssertion(x)
+	case axioms.DataPropertyAssertion:
+		v.VisitDataPropertyAssertion(x)
+	case assertions.NegativeDataPropertyAssertion:
+		v.VisitNegativeDataPropertyAssertion(x)
+	case annotations.AnnotationAssertion:
+		v.VisitAnnotationAssertion(x)
+	case annotations.SubAnnotationPropertyOf:
+		v.VisitSubAnnotationPropertyOf(x)
+	case annotations.AnnotationPropertyDomain:
+		v.VisitAnnotationPropertyDomain(x)
+	case annotations.AnnotationPropertyRange:
+		v.VisitAnnotationPropertyRange(x)
+	default:
+		return fmt.Errorf("cannot visit axiom of type %T", x)
+	}
+	return nil
+}
+
+// Visitor combines all visitor interfaces. It is needed by Walk.
+type Visitor interface {
+	ClassExpressionVisitor
+	DataRangeVisitor
+	ObjectPropertyExpressionVisitor
+	DataPropertyVisitor
+	AxiomVisitor
+}
+
+// Base implements Visitor with methods which do nothing.
+// Embed it into a visitor struct, and override the methods of interest.
+type Base struct{}
+
+var _ Visitor = Base{}
+
+func (Base) VisitClassDecl(*decl.ClassDecl)                                                        {}
+func (Base) VisitOWLThing(*classexpression.OWLThing)                                               {}
+func (Base) VisitOWLNothing(*classexpression.OWLNothing)                                           {}
+func (Base) VisitObjectIntersectionOf(*classexpression.ObjectIntersectionOf)                       {}
+func (Base) VisitObjectUnionOf(*classexpression.ObjectUnionOf)                                     {}
+func (Base) VisitObjectComplementOf(*classexpression.ObjectComplementOf)                           {}
+func (Base) VisitObjectOneOf(*classexpression.ObjectOneOf)                                         {}
+func (Base) VisitObjectSomeValuesFrom(*classexpression.ObjectSomeValuesFrom)                       {}
+func (Base) VisitObjectAllValuesFrom(*classexpression.ObjectAllValuesFrom)                         {}
+func (Base) VisitObjectHasValue(*classexpression.ObjectHasValue)                                   {}
+func (Base) VisitObjectHasSelf(*classexpression.ObjectHasSelf)                                     {}
+func (Base) VisitObjectMinCardinality(*classexpression.ObjectMinCardinality)                       {}
+func (Base) VisitObjectMaxCardinality(*classexpression.ObjectMaxCardinality)                       {}
+func (Base) VisitObjectExactCardinality(*classexpression.ObjectExactCardinality)                   {}
+func (Base) VisitObjectQualifiedMinCardinality(*classexpression.ObjectQualifiedMinCardinality)     {}
+func (Base) VisitObjectQualifiedMaxCardinality(*classexpression.ObjectQualifiedMaxCardinality)     {}
+func (Base) VisitObjectQualifiedExactCardinality(*classexpression.ObjectQualifiedExactCardinality) {}
+func (Base) VisitDataSomeValuesFrom(*classexpression.DataSomeValuesFrom)                           {}
+func (Base) VisitDataAllValuesFrom(*classexpression.DataAllValuesFrom)                             {}
+func (Base) VisitDataHasValue(*classexpression.DataHasValue)                                       {}
+func (Base) VisitDataMinCardinality(*classexpression.DataMinCardinality)                           {}
+func (Base) VisitDataMaxCardinality(*classexpression.DataMaxCardinality)                           {}
+func (Base) VisitDataExactCardinality(*classexpression.DataExactCardinality)                       {}
+func (Base) VisitDataQualifiedMinCardinality(*classexpression.DataQualifiedMinCardinality)         {}
+func (Base) VisitDataQualifiedMaxCardinality(*classexpression.DataQualifiedMaxCardinality)         {}
+func (Base) VisitDataQualifiedExactCardinality(*classexpression.DataQualifiedExactCardinality)     {}
+
+func (Base) VisitBuiltinDatatype(*facets.BuiltinDatatype)           {}
+func (Base) VisitCustomNamedDatatype(*facets.CustomNamedDatatype)   {}
+func (Base) VisitDatatypeDecl(*decl.DatatypeDecl)                   {}
+func (Base) VisitDatatypeRestriction(*facets.DatatypeRestriction)   {}
+func (Base) VisitDataComplementOf(*dataranges.DataComplementOf)     {}
+func (Base) VisitDataIntersectionOf(*dataranges.DataIntersectionOf) {}
+func (Base) VisitDataUnionOf(*dataranges.DataUnionOf)               {}
+func (Base) VisitDataOneOf(*dataranges.DataOneOf)                   {}
+
+func (Base) VisitObjectPropertyDecl(*decl.ObjectPropertyDecl)                 {}
+func (Base) VisitObjectInverseOf(*properties.ObjectInverseOf)                 {}
+func (Base) VisitOWLTopObjectProperty(*properties.OWLTopObjectProperty)       {}
+func (Base) VisitOWLBottomObjectProperty(*properties.OWLBottomObjectProperty) {}
+
+func (Base) VisitDataPropertyDecl(*decl.DataPropertyDecl)                 {}
+func (Base) VisitOWLTopDataProperty(*properties.OWLTopDataProperty)       {}
+func (Base) VisitOWLBottomDataProperty(*properties.OWLBottomDataProperty) {}
+
+func (Base) VisitDatatypeDefinition(axioms.DatatypeDefinition)                               {}
+func (Base) VisitSubClassOf(axioms.SubClassOf)                                               {}
+func (Base) VisitEquivalentClasses(axioms.EquivalentClasses)                                 {}
+func (Base) VisitDisjointClasses(axioms.DisjointClasses)                                     {}
+func (Base) VisitDisjointUnion(axioms.DisjointUnion)                                         {}
+func (Base) VisitSubObjectPropertyOf(axioms.SubObjectPropertyOf)                             {}
+func (Base) VisitSubObjectPropertyChainOf(axioms.SubObjectPropertyChainOf)                   {}
+func (Base) VisitEquivalentObjectProperties(axioms.EquivalentObjectProperties)               {}
+func (Base) VisitDisjointObjectProperties(axioms.DisjointObjectProperties)                   {}
+func (Base) VisitInverseObjectProperties(axioms.InverseObjectProperties)                     {}
+func (Base) VisitObjectPropertyDomain(axioms.ObjectPropertyDomain)                           {}
+func (Base) VisitObjectPropertyRange(axioms.ObjectPropertyRange)                             {}
+func (Base) VisitFunctionalObjectProperty(axioms.FunctionalObjectProperty)                   {}
+func (Base) VisitInverseFunctionalObjectProperty(axioms.InverseFunctionalObjectProperty)     {}
+func (Base) VisitReflexiveObjectProperty(axioms.ReflexiveObjectProperty)                     {}
+func (Base) VisitIrreflexiveObjectProperty(axioms.IrreflexiveObjectProperty)                 {}
+func (Base) VisitSymmetricObjectProperty(axioms.SymmetricObjectProperty)                     {}
+func (Base) VisitAsymmetricObjectProperty(axioms.AsymmetricObjectProperty)                   {}
+func (Base) VisitTransitiveObjectProperty(axioms.TransitiveObjectProperty)                   {}
+func (Base) VisitSubDataPropertyOf(axioms.SubDataPropertyOf)                                 {}
+func (Base) VisitEquivalentDataProperties(axioms.EquivalentDataProperties)                   {}
+func (Base) VisitDisjointDataProperties(axioms.DisjointDataProperties)                       {}
+func (Base) VisitDataPropertyDomain(axioms.DataPropertyDomain)                               {}
+func (Base) VisitDataPropertyRange(axioms.DataPropertyRange)                                 {}
+func (Base) VisitFunctionalDataProperty(axioms.FunctionalDataProperty)                       {}
+func (Base) VisitHasKey(axioms.HasKey)                                                       {}
+func (Base) VisitSameIndividual(axioms.SameIndividual)                                       {}
+func (Base) VisitDifferentIndividuals(axioms.DifferentIndividuals)                           {}
+func (Base) VisitClassAssertion(axioms.ClassAssertion)                                       {}
+func (Base) VisitObjectPropertyAssertion(assertions.ObjectPropertyAssertion)                 {}
+func (Base) VisitNegativeObjectPropertyAssertion(assertions.NegativeObjectPropertyAssertion) {}
+func (Base) VisitDataPropertyAssertion(axioms.DataPropertyAssertion)                         {}
+func (Base) VisitNegativeDataPropertyAssertion(assertions.NegativeDataPropertyAssertion)     {}
+func (Base) VisitAnnotationAssertion(annotations.AnnotationAssertion)                        {}
+func (Base) VisitSubAnnotationPropertyOf(annotations.SubAnnotationPropertyOf)                {}
+func (Base) VisitAnnotationPropertyDomain(annotations.AnnotationPropertyDomain)              {}
+func (Base) VisitAnnotationPropertyRange(annotations.AnnotationPropertyRange)                {}
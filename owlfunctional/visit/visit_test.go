@@ -0,0 +1,94 @@
+package visit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/properties"
+)
+
+const pizzaOntology = `Prefix(:=<http://example.com/pizza#>)
+Prefix(xsd:=<http://www.w3.org/2001/XMLSchema#>)
+
+Ontology(<http://example.com/pizza>
+	SubClassOf(:Margherita ObjectIntersectionOf(:Pizza ObjectSomeValuesFrom(:hasTopping :Cheese)))
+	SubClassOf(:Vegan ObjectAllValuesFrom(ObjectInverseOf(:isToppingOf) ObjectComplementOf(ObjectSomeValuesFrom(:hasIngredient :Meat))))
+	SubClassOf(:Light DataSomeValuesFrom(:hasCalories DatatypeRestriction(xsd:integer xsd:maxExclusive "400"^^xsd:integer)))
+	FunctionalObjectProperty(:hasBase)
+	TransitiveObjectProperty(:hasIngredient)
+	ClassAssertion(:Pizza :Diavolo)
+)
+`
+
+// counter counts some of the visited types, and records the classes in visiting order.
+type counter struct {
+	Base
+	classes    []string
+	somes      int
+	props      int
+	transitive int
+}
+
+func (s *counter) VisitClassDecl(C *decl.ClassDecl) {
+	s.classes = append(s.classes, C.IRI[strings.Index(C.IRI, "#")+1:])
+}
+
+func (s *counter) VisitObjectSomeValuesFrom(*classexpression.ObjectSomeValuesFrom) {
+	s.somes++
+}
+
+func (s *counter) VisitObjectPropertyDecl(*decl.ObjectPropertyDecl) {
+	s.props++
+}
+
+func (s *counter) VisitObjectInverseOf(*properties.ObjectInverseOf) {
+	s.props++
+}
+
+func (s *counter) VisitTransitiveObjectProperty(axioms.TransitiveObjectProperty) {
+	s.transitive++
+}
+
+func TestWalkAxioms(t *testing.T) {
+	o, err := gofp.OntologyFromReader(strings.NewReader(pizzaOntology), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &counter{}
+	if err = WalkAxioms(o.K, c); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(c.classes, " ") != "Margherita Pizza Cheese Vegan Meat Light Pizza" {
+		t.Fatal(c.classes)
+	}
+	if c.somes != 2 || c.props != 5 || c.transitive != 1 {
+		t.Fatal(c.somes, c.props, c.transitive)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	o, err := gofp.OntologyFromReader(strings.NewReader(pizzaOntology), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &counter{}
+	if err = Walk(o.K.AllSubClassOfs()[0].C2, c); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(c.classes, " ") != "Pizza Cheese" || c.somes != 1 {
+		t.Fatal(c.classes, c.somes)
+	}
+}
+
+func TestUnknownType(t *testing.T) {
+	if err := Walk(42, Base{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if err := Walk(axioms.SubClassOf{C1: &classexpression.OWLThing{}}, Base{}); err == nil {
+		t.Fatal("expected an error for nil C2")
+	}
+}
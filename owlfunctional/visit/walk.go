@@ -0,0 +1,371 @@
+package visit
+
+import (
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/assertions"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Walk traverses x depth-first. x is an axiom, a class expression, a data range,
+// an object property expression or a data property.
+// Each node is visited before its operands, which are visited in the order of OWL-Functional syntax.
+// Individuals, literals and annotation properties are leaves, which are not visited.
+// The definition of a facets.CustomNamedDatatype is not followed; it is walked with its DatatypeDefinition axiom.
+// An error is returned for unknown types.
+func Walk(x interface{}, v Visitor) error {
+	w := &walker{v: v}
+	switch x := x.(type) {
+	case meta.ClassExpression:
+		w.classExpression(x)
+	case meta.DataRange:
+		w.dataRange(x)
+	case meta.ObjectPropertyExpression:
+		w.objectPropertyExpression(x)
+	case meta.DataProperty:
+		w.dataProperty(x)
+	default:
+		w.axiom(x)
+	}
+	return w.err
+}
+
+// WalkAxioms walks all axioms of k, see Walk. The axioms are grouped by type,
+// in the same order as with the OWL-Functional writer.
+func WalkAxioms(k storedefaults.K, v Visitor) error {
+	w := &walker{v: v}
+	for _, x := range k.AllDatatypeDefinitions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllSubClassOfs() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllEquivalentClasses() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDisjointClasses() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDisjointUnions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllSubObjectPropertyOfs() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllSubObjectPropertyChainOfs() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllEquivalentObjectProperties() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDisjointObjectProperties() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllInverseObjectProperties() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllObjectPropertyDomains() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllObjectPropertyRanges() {
+		w.axiom(x)
+	}
+	for _, P := range k.AllFunctionalObjectProperties() {
+		w.axiom(axioms.FunctionalObjectProperty{P: P})
+	}
+	for _, P := range k.AllInverseFunctionalObjectProperties() {
+		w.axiom(axioms.InverseFunctionalObjectProperty{P: P})
+	}
+	for _, P := range k.AllReflexiveObjectProperties() {
+		w.axiom(axioms.ReflexiveObjectProperty{P: P})
+	}
+	for _, P := range k.AllIrreflexiveObjectProperties() {
+		w.axiom(axioms.IrreflexiveObjectProperty{P: P})
+	}
+	for _, P := range k.AllSymmetricObjectProperties() {
+		w.axiom(axioms.SymmetricObjectProperty{P: P})
+	}
+	for _, P := range k.AllAsymmetricObjectProperties() {
+		w.axiom(axioms.AsymmetricObjectProperty{P: P})
+	}
+	for _, P := range k.AllTransitiveObjectProperties() {
+		w.axiom(axioms.TransitiveObjectProperty{P: P})
+	}
+	for _, x := range k.AllSubDataPropertyOfs() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllEquivalentDataProperties() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDisjointDataProperties() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDataPropertyDomains() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDataPropertyRanges() {
+		w.axiom(x)
+	}
+	for _, R := range k.AllFunctionalDataProperties() {
+		w.axiom(axioms.FunctionalDataProperty{R: R})
+	}
+	for _, x := range k.AllHasKeys() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllSameIndividuals() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDifferentIndividuals() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllClassAssertions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllObjectPropertyAssertions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllNegativeObjectPropertyAssertions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllDataPropertyAssertions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllNegativeDataPropertyAssertions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllAnnotationAssertions() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllSubAnnotationPropertyOfs() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllAnnotationPropertyDomains() {
+		w.axiom(x)
+	}
+	for _, x := range k.AllAnnotationPropertyRanges() {
+		w.axiom(x)
+	}
+	return w.err
+}
+
+// walker keeps the first error. After an error, nothing more is visited.
+type walker struct {
+	v   Visitor
+	err error
+}
+
+func (w *walker) axiom(x interface{}) {
+	if w.err != nil {
+		return
+	}
+	if w.err = Axiom(x, w.v); w.err != nil {
+		return
+	}
+	switch x := x.(type) {
+	case axioms.DatatypeDefinition:
+		w.dataRange(x.DN)
+		w.dataRange(x.D)
+	case axioms.SubClassOf:
+		w.classExpression(x.C1)
+		w.classExpression(x.C2)
+	case axioms.EquivalentClasses:
+		w.classExpressions(x.EquivalentClasses)
+	case axioms.DisjointClasses:
+		w.classExpressions(x.DisjointClasses)
+	case axioms.DisjointUnion:
+		w.classExpression(x.CN)
+		w.classExpressions(x.DisjointClasses)
+	case axioms.SubObjectPropertyOf:
+		w.objectPropertyExpression(x.P1)
+		w.objectPropertyExpression(x.P2)
+	case axioms.SubObjectPropertyChainOf:
+		w.objectPropertyExpressions(x.Chain)
+		w.objectPropertyExpression(x.P)
+	case axioms.EquivalentObjectProperties:
+		w.objectPropertyExpressions(x.Ps)
+	case axioms.DisjointObjectProperties:
+		w.objectPropertyExpressions(x.Ps)
+	case axioms.InverseObjectProperties:
+		w.objectPropertyExpression(x.P1)
+		w.objectPropertyExpression(x.P2)
+	case axioms.ObjectPropertyDomain:
+		w.objectPropertyExpression(x.P)
+		w.classExpression(x.C)
+	case axioms.ObjectPropertyRange:
+		w.objectPropertyExpression(x.P)
+		w.classExpression(x.C)
+	case axioms.FunctionalObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.InverseFunctionalObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.ReflexiveObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.IrreflexiveObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.SymmetricObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.AsymmetricObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.TransitiveObjectProperty:
+		w.objectPropertyExpression(x.P)
+	case axioms.SubDataPropertyOf:
+		w.dataProperty(x.P1)
+		w.dataProperty(x.P2)
+	case axioms.EquivalentDataProperties:
+		w.dataProperties(x.Rs)
+	case axioms.DisjointDataProperties:
+		w.dataProperties(x.Rs)
+	case axioms.DataPropertyDomain:
+		w.dataProperty(x.R)
+		w.classExpression(x.C)
+	case axioms.DataPropertyRange:
+		w.dataProperty(x.R)
+		w.dataRange(x.D)
+	case axioms.FunctionalDataProperty:
+		w.dataProperty(x.R)
+	case axioms.HasKey:
+		w.classExpression(x.C)
+		w.objectPropertyExpressions(x.Ps)
+		w.dataProperties(x.Rs)
+	case axioms.ClassAssertion:
+		w.classExpression(x.C)
+	case assertions.ObjectPropertyAssertion:
+		w.objectPropertyExpression(x.P)
+	case assertions.NegativeObjectPropertyAssertion:
+		w.objectPropertyExpression(x.P)
+	case axioms.DataPropertyAssertion:
+		w.dataProperty(x.R)
+	case assertions.NegativeDataPropertyAssertion:
+		w.dataProperty(x.R)
+	case axioms.SameIndividual, axioms.DifferentIndividuals,
+		annotations.AnnotationAssertion, annotations.SubAnnotationPropertyOf,
+		annotations.AnnotationPropertyDomain, annotations.AnnotationPropertyRange:
+		// no operands to walk
+	}
+}
+
+func (w *walker) classExpression(C meta.ClassExpression) {
+	if w.err != nil {
+		return
+	}
+	if w.err = ClassExpression(C, w.v); w.err != nil {
+		return
+	}
+	switch C := C.(type) {
+	case *classexpression.ObjectIntersectionOf:
+		w.classExpressions(C.Cs)
+	case *classexpression.ObjectUnionOf:
+		w.classExpressions(C.Cs)
+	case *classexpression.ObjectComplementOf:
+		w.classExpression(C.C)
+	case *classexpression.ObjectSomeValuesFrom:
+		w.objectPropertyExpression(C.P)
+		w.classExpression(C.C)
+	case *classexpression.ObjectAllValuesFrom:
+		w.objectPropertyExpression(C.P)
+		w.classExpression(C.C)
+	case *classexpression.ObjectHasValue:
+		w.objectPropertyExpression(C.P)
+	case *classexpression.ObjectHasSelf:
+		w.objectPropertyExpression(C.P)
+	case *classexpression.ObjectMinCardinality:
+		w.objectPropertyExpression(C.P)
+	case *classexpression.ObjectMaxCardinality:
+		w.objectPropertyExpression(C.P)
+	case *classexpression.ObjectExactCardinality:
+		w.objectPropertyExpression(C.P)
+	case *classexpression.ObjectQualifiedMinCardinality:
+		w.objectPropertyExpression(C.P)
+		w.classExpression(C.C)
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		w.objectPropertyExpression(C.P)
+		w.classExpression(C.C)
+	case *classexpression.ObjectQualifiedExactCardinality:
+		w.objectPropertyExpression(C.P)
+		w.classExpression(C.C)
+	case *classexpression.DataSomeValuesFrom:
+		w.dataProperty(C.R)
+		w.dataRange(C.D)
+	case *classexpression.DataAllValuesFrom:
+		w.dataProperty(C.R)
+		w.dataRange(C.D)
+	case *classexpression.DataHasValue:
+		w.dataProperty(C.R)
+	case *classexpression.DataMinCardinality:
+		w.dataProperty(C.R)
+	case *classexpression.DataMaxCardinality:
+		w.dataProperty(C.R)
+	case *classexpression.DataExactCardinality:
+		w.dataProperty(C.R)
+	case *classexpression.DataQualifiedMinCardinality:
+		w.dataProperty(C.R)
+		w.dataRange(C.D)
+	case *classexpression.DataQualifiedMaxCardinality:
+		w.dataProperty(C.R)
+		w.dataRange(C.D)
+	case *classexpression.DataQualifiedExactCardinality:
+		w.dataProperty(C.R)
+		w.dataRange(C.D)
+	}
+}
+
+func (w *walker) classExpressions(Cs []meta.ClassExpression) {
+	for _, C := range Cs {
+		w.classExpression(C)
+	}
+}
+
+func (w *walker) dataRange(D meta.DataRange) {
+	if w.err != nil {
+		return
+	}
+	if w.err = DataRange(D, w.v); w.err != nil {
+		return
+	}
+	switch D := D.(type) {
+	case *facets.DatatypeRestriction:
+		w.dataRange(D.DN)
+	case *dataranges.DataComplementOf:
+		w.dataRange(D.D)
+	case *dataranges.DataIntersectionOf:
+		w.dataRanges(D.Ds)
+	case *dataranges.DataUnionOf:
+		w.dataRanges(D.Ds)
+	}
+}
+
+func (w *walker) dataRanges(Ds []meta.DataRange) {
+	for _, D := range Ds {
+		w.dataRange(D)
+	}
+}
+
+func (w *walker) objectPropertyExpression(P meta.ObjectPropertyExpression) {
+	if w.err != nil {
+		return
+	}
+	w.err = ObjectPropertyExpression(P, w.v)
+}
+
+func (w *walker) objectPropertyExpressions(Ps []meta.ObjectPropertyExpression) {
+	for _, P := range Ps {
+		w.objectPropertyExpression(P)
+	}
+}
+
+func (w *walker) dataProperty(R meta.DataProperty) {
+	if w.err != nil {
+		return
+	}
+	w.err = DataProperty(R, w.v)
+}
+
+func (w *walker) dataProperties(Rs []meta.DataProperty) {
+	for _, R := range Rs {
+		w.dataProperty(R)
+	}
+}
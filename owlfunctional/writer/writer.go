@@ -0,0 +1,540 @@
+// writer serializes a parsed Ontology back into OWL-Functional syntax.
+// The output can be parsed again with gofp.OntologyFromReader.
+package writer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/owlfunctional/properties"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Write writes the ontology o in OWL-Functional syntax to w.
+// Written are the prefixes, the ontology IRI and version IRI, the imports, the ontology annotations,
+// all declarations (including the implicit ones) and all axioms from o.K.
+// IRIs are shortened with o.Prefixes wherever the result is a valid prefixed name.
+// Declarations are sorted by IRI. Axioms are grouped by type and keep the order of o.K within each type.
+// Axioms are written with their annotations if o.K implements storedefaults.AnnotatedAxioms, as the default stores do.
+// o.K must be set, i.e. o must be parsed into the default structures of the storedefaults package.
+func Write(w io.Writer, o *owlfunctional.Ontology) (err error) {
+	if o.K == nil {
+		return fmt.Errorf("cannot write ontology %v without K", o.IRI)
+	}
+	bw := bufio.NewWriter(w)
+	s := newFw(bw, o.Prefixes)
+	s.writeOntology(o)
+	if s.err != nil {
+		return s.err
+	}
+	return bw.Flush()
+}
+
+// String returns the ontology o in OWL-Functional syntax, see Write.
+func String(o *owlfunctional.Ontology) (res string, err error) {
+	var sb strings.Builder
+	if err = Write(&sb, o); err != nil {
+		return
+	}
+	return sb.String(), nil
+}
+
+// fw writes OWL-Functional. The first error is kept in err, after which writing continues silently.
+type fw struct {
+	w           io.Writer
+	prefixes    map[string]string
+	prefixNames []string // sorted keys of prefixes
+	k           storedefaults.K
+	err         error
+}
+
+func newFw(w io.Writer, prefixes map[string]string) *fw {
+	s := &fw{w: w, prefixes: prefixes}
+	for name := range prefixes {
+		s.prefixNames = append(s.prefixNames, name)
+	}
+	sort.Strings(s.prefixNames)
+	return s
+}
+
+func (s *fw) writeOntology(o *owlfunctional.Ontology) {
+	for _, name := range s.prefixNames {
+		s.text("Prefix(%v:=<%v>)\n", name, s.prefixes[name])
+	}
+	s.text("\n")
+
+	header := "Ontology("
+	if o.IRI != "" {
+		header += o.IRI
+		if o.VERSIONIRI != "" {
+			header += " " + o.VERSIONIRI
+		}
+	}
+	s.text("%v\n", header)
+
+	for _, iri := range o.Imports {
+		s.line("Import(<%v>)", iri)
+	}
+	for _, a := range o.Annotations() {
+		s.line("%v", s.annotation(&a))
+	}
+
+	s.writeDecls(o.K)
+	s.writeAxioms(o.K)
+	s.text(")\n")
+}
+
+func (s *fw) writeDecls(k storedefaults.K) {
+	var iris []string
+	declLines := func(kind string) {
+		sort.Strings(iris)
+		for _, iri := range iris {
+			s.line("Declaration(%v(%v))", kind, s.iri(iri))
+		}
+		iris = iris[:0]
+	}
+
+	for _, d := range k.AllClassDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declLines("Class")
+	for _, d := range k.AllObjectPropertyDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declLines("ObjectProperty")
+	for _, d := range k.AllDataPropertyDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declLines("DataProperty")
+	for _, d := range k.AllAnnotationPropertyDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declLines("AnnotationProperty")
+	for _, d := range k.AllDatatypeDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declLines("Datatype")
+	for _, d := range k.AllNamedIndividualDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declLines("NamedIndividual")
+}
+
+func (s *fw) writeAxioms(k storedefaults.K) {
+	s.k = k
+
+	// Datatype definitions come first, so that the defined datatypes are resolved when parsing the other axioms.
+	for i, x := range k.AllDatatypeDefinitions() {
+		s.axiom(storedefaults.KindDatatypeDefinition, i, "%v %v", s.D(x.DN), s.D(x.D))
+	}
+
+	// Class expression axioms
+	for i, x := range k.AllSubClassOfs() {
+		s.axiom(storedefaults.KindSubClassOf, i, "%v %v", s.C(x.C1), s.C(x.C2))
+	}
+	for i, x := range k.AllEquivalentClasses() {
+		s.axiom(storedefaults.KindEquivalentClasses, i, "%v", s.Cs(x.EquivalentClasses))
+	}
+	for i, x := range k.AllDisjointClasses() {
+		s.axiom(storedefaults.KindDisjointClasses, i, "%v", s.Cs(x.DisjointClasses))
+	}
+	for i, x := range k.AllDisjointUnions() {
+		s.axiom(storedefaults.KindDisjointUnion, i, "%v %v", s.C(x.CN), s.Cs(x.DisjointClasses))
+	}
+
+	// Object property axioms
+	for i, x := range k.AllSubObjectPropertyOfs() {
+		s.axiom(storedefaults.KindSubObjectPropertyOf, i, "%v %v", s.P(x.P1), s.P(x.P2))
+	}
+	for i, x := range k.AllSubObjectPropertyChainOfs() {
+		s.axiomNamed("SubObjectPropertyOf", storedefaults.KindSubObjectPropertyChainOf, i, "ObjectPropertyChain(%v) %v", s.Ps(x.Chain), s.P(x.P))
+	}
+	for i, x := range k.AllEquivalentObjectProperties() {
+		s.axiom(storedefaults.KindEquivalentObjectProperties, i, "%v", s.Ps(x.Ps))
+	}
+	for i, x := range k.AllDisjointObjectProperties() {
+		s.axiom(storedefaults.KindDisjointObjectProperties, i, "%v", s.Ps(x.Ps))
+	}
+	for i, x := range k.AllInverseObjectProperties() {
+		s.axiom(storedefaults.KindInverseObjectProperties, i, "%v %v", s.P(x.P1), s.P(x.P2))
+	}
+	for i, x := range k.AllObjectPropertyDomains() {
+		s.axiom(storedefaults.KindObjectPropertyDomain, i, "%v %v", s.P(x.P), s.C(x.C))
+	}
+	for i, x := range k.AllObjectPropertyRanges() {
+		s.axiom(storedefaults.KindObjectPropertyRange, i, "%v %v", s.P(x.P), s.C(x.C))
+	}
+	s.pLines(storedefaults.KindFunctionalObjectProperty, k.AllFunctionalObjectProperties())
+	s.pLines(storedefaults.KindInverseFunctionalObjectProperty, k.AllInverseFunctionalObjectProperties())
+	s.pLines(storedefaults.KindReflexiveObjectProperty, k.AllReflexiveObjectProperties())
+	s.pLines(storedefaults.KindIrreflexiveObjectProperty, k.AllIrreflexiveObjectProperties())
+	s.pLines(storedefaults.KindSymmetricObjectProperty, k.AllSymmetricObjectProperties())
+	s.pLines(storedefaults.KindAsymmetricObjectProperty, k.AllAsymmetricObjectProperties())
+	s.pLines(storedefaults.KindTransitiveObjectProperty, k.AllTransitiveObjectProperties())
+
+	// Data property axioms
+	for i, x := range k.AllSubDataPropertyOfs() {
+		s.axiom(storedefaults.KindSubDataPropertyOf, i, "%v %v", s.R(x.P1), s.R(x.P2))
+	}
+	for i, x := range k.AllEquivalentDataProperties() {
+		s.axiom(storedefaults.KindEquivalentDataProperties, i, "%v", s.Rs(x.Rs))
+	}
+	for i, x := range k.AllDisjointDataProperties() {
+		s.axiom(storedefaults.KindDisjointDataProperties, i, "%v", s.Rs(x.Rs))
+	}
+	for i, x := range k.AllDataPropertyDomains() {
+		s.axiom(storedefaults.KindDataPropertyDomain, i, "%v %v", s.R(x.R), s.C(x.C))
+	}
+	for i, x := range k.AllDataPropertyRanges() {
+		s.axiom(storedefaults.KindDataPropertyRange, i, "%v %v", s.R(x.R), s.D(x.D))
+	}
+	for i, R := range k.AllFunctionalDataProperties() {
+		s.axiom(storedefaults.KindFunctionalDataProperty, i, "%v", s.R(R))
+	}
+
+	// Keys
+	for i, x := range k.AllHasKeys() {
+		s.axiom(storedefaults.KindHasKey, i, "%v (%v) (%v)", s.C(x.C), s.Ps(x.Ps), s.Rs(x.Rs))
+	}
+
+	// Assertions
+	for i, x := range k.AllSameIndividuals() {
+		s.axiom(storedefaults.KindSameIndividual, i, "%v", s.As(x.As))
+	}
+	for i, x := range k.AllDifferentIndividuals() {
+		s.axiom(storedefaults.KindDifferentIndividuals, i, "%v", s.As(x.As))
+	}
+	for i, x := range k.AllClassAssertions() {
+		s.axiom(storedefaults.KindClassAssertion, i, "%v %v", s.C(x.C), s.A(x.A))
+	}
+	for i, x := range k.AllObjectPropertyAssertions() {
+		s.axiom(storedefaults.KindObjectPropertyAssertion, i, "%v %v %v", s.P(x.P), s.A(x.A1), s.A(x.A2))
+	}
+	for i, x := range k.AllNegativeObjectPropertyAssertions() {
+		s.axiom(storedefaults.KindNegativeObjectPropertyAssertion, i, "%v %v %v", s.P(x.P), s.A(x.A1), s.A(x.A2))
+	}
+	for i, x := range k.AllDataPropertyAssertions() {
+		s.axiom(storedefaults.KindDataPropertyAssertion, i, "%v %v %v", s.R(x.R), s.A(x.A), s.literal(x.V))
+	}
+	for i, x := range k.AllNegativeDataPropertyAssertions() {
+		s.axiom(storedefaults.KindNegativeDataPropertyAssertion, i, "%v %v %v", s.R(x.R), s.A(x.A), s.literal(x.V))
+	}
+
+	// Annotation axioms
+	for i, x := range k.AllAnnotationAssertions() {
+		s.axiom(storedefaults.KindAnnotationAssertion, i, "%v %v %v", s.annotationProperty(x.A), s.st(x.S), s.st(x.T))
+	}
+	for i, x := range k.AllSubAnnotationPropertyOfs() {
+		s.axiom(storedefaults.KindSubAnnotationPropertyOf, i, "%v %v", s.annotationProperty(x.A1), s.annotationProperty(x.A2))
+	}
+	for i, x := range k.AllAnnotationPropertyDomains() {
+		s.axiom(storedefaults.KindAnnotationPropertyDomain, i, "%v %v", s.annotationProperty(x.A), s.iri(x.U))
+	}
+	for i, x := range k.AllAnnotationPropertyRanges() {
+		s.axiom(storedefaults.KindAnnotationPropertyRange, i, "%v %v", s.annotationProperty(x.A), s.iri(x.U))
+	}
+}
+
+func (s *fw) pLines(kind storedefaults.AxiomKind, Ps []meta.ObjectPropertyExpression) {
+	for i, P := range Ps {
+		s.axiom(kind, i, "%v", s.P(P))
+	}
+}
+
+// axiom writes the i-th axiom of the given kind as a single line, starting with the axiom annotations.
+func (s *fw) axiom(kind storedefaults.AxiomKind, i int, format string, args ...interface{}) {
+	s.axiomNamed(kind.String(), kind, i, format, args...)
+}
+
+// axiomNamed is like axiom, for axioms which are written with another name than their kind.
+func (s *fw) axiomNamed(name string, kind storedefaults.AxiomKind, i int, format string, args ...interface{}) {
+	var anns string
+	if k, ok := s.k.(storedefaults.AnnotatedAxioms); ok {
+		for _, a := range k.AxiomAnnotations(kind, i) {
+			anns += s.annotation(a) + " "
+		}
+	}
+	s.line("%v(%v%v)", name, anns, fmt.Sprintf(format, args...))
+}
+
+// line writes a single line, indented inside the Ontology(...) element.
+func (s *fw) line(format string, args ...interface{}) {
+	s.text("\t"+format+"\n", args...)
+}
+
+func (s *fw) text(format string, args ...interface{}) {
+	if s.err != nil {
+		return
+	}
+	_, s.err = fmt.Fprintf(s.w, format, args...)
+}
+
+// unknown records an error for a type which the writer cannot serialize, e.g. from a custom store implementation.
+func (s *fw) unknown(what string, x interface{}) string {
+	if s.err == nil {
+		s.err = fmt.Errorf("cannot write %v of type %T", what, x)
+	}
+	return ""
+}
+
+// iri returns iri as prefixed name, if possible, and otherwise as full IRI in <>.
+// With multiple matching prefixes, the longest one wins.
+func (s *fw) iri(iri string) string {
+	var best string
+	var bestHead string
+	var found bool
+	for _, name := range s.prefixNames {
+		head := s.prefixes[name]
+		if !strings.HasPrefix(iri, head) || (found && len(head) <= len(bestHead)) {
+			continue
+		}
+		if isLocalName(iri[len(head):]) {
+			best, bestHead, found = name, head, true
+		}
+	}
+	if found {
+		return parser.FmtPrefixedName(best, iri[len(bestHead):])
+	}
+	return "<" + iri + ">"
+}
+
+// isLocalName is true if name, written after "prefix:", is read back by the parser as the same name.
+func isLocalName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, ch := range name {
+		if i == 0 && !unicode.IsLetter(ch) {
+			return false
+		}
+		if !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' && ch != '-' {
+			return false
+		}
+	}
+	// Each part between hyphens is scanned separately, and must not become a keyword token.
+	for _, part := range strings.Split(name, "-") {
+		if parser.IsKeyword(strings.TrimLeftFunc(part, unicode.IsDigit)) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *fw) literal(l literal.OWLLiteral) string {
+	res := `"` + l.Value + `"`
+	if l.LangTag != "" {
+		return res + "@" + l.LangTag
+	}
+	if l.Literaltype != "" {
+		res += "^^" + s.iri(l.Literaltype)
+	}
+	return res
+}
+
+// st writes the value of an annotation subject or target.
+// The parser keeps these as strings, which are either an IRI, a node ID (_:...) or a literal (see literal.OWLLiteral.LiteralString).
+func (s *fw) st(value string) string {
+	if l, ok := literal.ParseLiteralString(value); ok {
+		return s.literal(l)
+	}
+	if strings.HasPrefix(value, "_:") {
+		return value
+	}
+	return s.iri(value)
+}
+
+func (s *fw) annotation(a meta.Annotation) string {
+	return fmt.Sprintf("Annotation(%v %v)", s.annotationProperty(a.A()), s.st(a.T()))
+}
+
+func (s *fw) annotationProperty(A meta.AnnotationProperty) string {
+	switch A := A.(type) {
+	case *decl.AnnotationPropertyDecl:
+		return s.iri(A.IRI)
+	case string:
+		// SubAnnotationPropertyOf keeps the IRIs only
+		return s.iri(A)
+	}
+	return s.unknown("annotation property", A)
+}
+
+// A writes an individual.
+func (s *fw) A(a individual.Individual) string {
+	if a.IsAnonymous() {
+		return a.NodeID
+	}
+	return s.iri(a.Name)
+}
+
+func (s *fw) As(as []individual.Individual) string {
+	parts := make([]string, len(as))
+	for i, a := range as {
+		parts[i] = s.A(a)
+	}
+	return strings.Join(parts, " ")
+}
+
+// C writes a class expression.
+func (s *fw) C(C meta.ClassExpression) string {
+	switch x := C.(type) {
+	case *decl.ClassDecl:
+		return s.iri(x.IRI)
+	case *classexpression.OWLThing:
+		return s.iri(builtindatatypes.PRE_OWL + "Thing")
+	case *classexpression.OWLNothing:
+		return s.iri(builtindatatypes.PRE_OWL + "Nothing")
+	case *classexpression.ObjectIntersectionOf:
+		return "ObjectIntersectionOf(" + s.Cs(x.Cs) + ")"
+	case *classexpression.ObjectUnionOf:
+		return "ObjectUnionOf(" + s.Cs(x.Cs) + ")"
+	case *classexpression.ObjectComplementOf:
+		return "ObjectComplementOf(" + s.C(x.C) + ")"
+	case *classexpression.ObjectOneOf:
+		return "ObjectOneOf(" + s.As(x.As) + ")"
+	case *classexpression.ObjectSomeValuesFrom:
+		return fmt.Sprintf("ObjectSomeValuesFrom(%v %v)", s.P(x.P), s.C(x.C))
+	case *classexpression.ObjectAllValuesFrom:
+		return fmt.Sprintf("ObjectAllValuesFrom(%v %v)", s.P(x.P), s.C(x.C))
+	case *classexpression.ObjectHasValue:
+		return fmt.Sprintf("ObjectHasValue(%v %v)", s.P(x.P), s.A(x.A))
+	case *classexpression.ObjectHasSelf:
+		return fmt.Sprintf("ObjectHasSelf(%v)", s.P(x.P))
+	case *classexpression.ObjectMinCardinality:
+		return fmt.Sprintf("ObjectMinCardinality(%d %v)", x.N, s.P(x.P))
+	case *classexpression.ObjectMaxCardinality:
+		return fmt.Sprintf("ObjectMaxCardinality(%d %v)", x.N, s.P(x.P))
+	case *classexpression.ObjectExactCardinality:
+		return fmt.Sprintf("ObjectExactCardinality(%d %v)", x.N, s.P(x.P))
+	case *classexpression.ObjectQualifiedMinCardinality:
+		return fmt.Sprintf("ObjectMinCardinality(%d %v %v)", x.N, s.P(x.P), s.C(x.C))
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		return fmt.Sprintf("ObjectMaxCardinality(%d %v %v)", x.N, s.P(x.P), s.C(x.C))
+	case *classexpression.ObjectQualifiedExactCardinality:
+		return fmt.Sprintf("ObjectExactCardinality(%d %v %v)", x.N, s.P(x.P), s.C(x.C))
+	case *classexpression.DataSomeValuesFrom:
+		return fmt.Sprintf("DataSomeValuesFrom(%v %v)", s.R(x.R), s.D(x.D))
+	case *classexpression.DataAllValuesFrom:
+		return fmt.Sprintf("DataAllValuesFrom(%v %v)", s.R(x.R), s.D(x.D))
+	case *classexpression.DataHasValue:
+		return fmt.Sprintf("DataHasValue(%v %v)", s.R(x.R), s.literal(x.V))
+	case *classexpression.DataMinCardinality:
+		return fmt.Sprintf("DataMinCardinality(%d %v)", x.N, s.R(x.R))
+	case *classexpression.DataMaxCardinality:
+		return fmt.Sprintf("DataMaxCardinality(%d %v)", x.N, s.R(x.R))
+	case *classexpression.DataExactCardinality:
+		return fmt.Sprintf("DataExactCardinality(%d %v)", x.N, s.R(x.R))
+	case *classexpression.DataQualifiedMinCardinality:
+		return fmt.Sprintf("DataMinCardinality(%d %v %v)", x.N, s.R(x.R), s.D(x.D))
+	case *classexpression.DataQualifiedMaxCardinality:
+		return fmt.Sprintf("DataMaxCardinality(%d %v %v)", x.N, s.R(x.R), s.D(x.D))
+	case *classexpression.DataQualifiedExactCardinality:
+		return fmt.Sprintf("DataExactCardinality(%d %v %v)", x.N, s.R(x.R), s.D(x.D))
+	}
+	return s.unknown("class expression", C)
+}
+
+func (s *fw) Cs(Cs []meta.ClassExpression) string {
+	parts := make([]string, len(Cs))
+	for i, C := range Cs {
+		parts[i] = s.C(C)
+	}
+	return strings.Join(parts, " ")
+}
+
+// P writes an object property expression.
+func (s *fw) P(P meta.ObjectPropertyExpression) string {
+	switch x := P.(type) {
+	case *decl.ObjectPropertyDecl:
+		return s.iri(x.IRI)
+	case *properties.ObjectInverseOf:
+		return "ObjectInverseOf(" + s.iri(x.PN) + ")"
+	case *properties.OWLTopObjectProperty:
+		return s.iri(builtindatatypes.PRE_OWL + "topObjectProperty")
+	case *properties.OWLBottomObjectProperty:
+		return s.iri(builtindatatypes.PRE_OWL + "bottomObjectProperty")
+	}
+	return s.unknown("object property expression", P)
+}
+
+func (s *fw) Ps(Ps []meta.ObjectPropertyExpression) string {
+	parts := make([]string, len(Ps))
+	for i, P := range Ps {
+		parts[i] = s.P(P)
+	}
+	return strings.Join(parts, " ")
+}
+
+// R writes a data property.
+func (s *fw) R(R meta.DataProperty) string {
+	switch x := R.(type) {
+	case *decl.DataPropertyDecl:
+		return s.iri(x.IRI)
+	case *properties.OWLTopDataProperty:
+		return s.iri(builtindatatypes.PRE_OWL + "topDataProperty")
+	case *properties.OWLBottomDataProperty:
+		return s.iri(builtindatatypes.PRE_OWL + "bottomDataProperty")
+	}
+	return s.unknown("data property", R)
+}
+
+func (s *fw) Rs(Rs []meta.DataProperty) string {
+	parts := make([]string, len(Rs))
+	for i, R := range Rs {
+		parts[i] = s.R(R)
+	}
+	return strings.Join(parts, " ")
+}
+
+// D writes a data range.
+func (s *fw) D(D meta.DataRange) string {
+	switch x := D.(type) {
+	case *facets.BuiltinDatatype:
+		return s.iri(x.DatatypeIRI)
+	case *facets.CustomNamedDatatype:
+		return s.iri(x.DatatypeIRI)
+	case *decl.DatatypeDecl:
+		return s.iri(x.IRI)
+	case *facets.DatatypeRestriction:
+		parts := make([]string, 0, len(x.FVPairs)+1)
+		parts = append(parts, s.D(x.DN))
+		for _, fv := range x.FVPairs {
+			parts = append(parts, s.iri(fv.F.IRI())+" "+s.literal(fv.V))
+		}
+		return "DatatypeRestriction(" + strings.Join(parts, " ") + ")"
+	case *dataranges.DataComplementOf:
+		return "DataComplementOf(" + s.D(x.D) + ")"
+	case *dataranges.DataIntersectionOf:
+		return "DataIntersectionOf(" + s.Ds(x.Ds) + ")"
+	case *dataranges.DataUnionOf:
+		return "DataUnionOf(" + s.Ds(x.Ds) + ")"
+	case *dataranges.DataOneOf:
+		parts := make([]string, len(x.Vs))
+		for i, v := range x.Vs {
+			parts[i] = s.literal(v)
+		}
+		return "DataOneOf(" + strings.Join(parts, " ") + ")"
+	}
+	return s.unknown("data range", D)
+}
+
+func (s *fw) Ds(Ds []meta.DataRange) string {
+	parts := make([]string, len(Ds))
+	for i, D := range Ds {
+		parts[i] = s.D(D)
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,164 @@
+package writer
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp/mock/mockontology"
+	"github.com/shful/gofp/owlfunctional/structural"
+	"github.com/shful/gofp/storedefaults"
+)
+
+func TestWriteRoundtrip(t *testing.T) {
+	o1 := mockontology.Pizza(t)
+	text1, err := String(o1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o2 := mockontology.Parse(t, text1)
+	text2, err := String(o2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text1 != text2 {
+		t.Fatalf("roundtrip differs:\n%v\n---\n%v", text1, text2)
+	}
+
+	if o2.IRI != o1.IRI || o2.VERSIONIRI != o1.VERSIONIRI || len(o2.Imports) != 1 || len(o2.Annotations()) != 1 {
+		t.Fatal(o2.IRI, o2.VERSIONIRI, o2.Imports, o2.Annotations())
+	}
+	if len(o1.K.AllSubClassOfs()) != 11 {
+		t.Fatal(o1.K.AllSubClassOfs())
+	}
+	keys1, keys2 := axiomKeys(o1.K), axiomKeys(o2.K)
+	if len(keys1) != len(keys2) {
+		t.Fatalf("%v axioms and declarations before, %v after the roundtrip", len(keys1), len(keys2))
+	}
+	for i := range keys1 {
+		if keys1[i] != keys2[i] {
+			t.Fatalf("roundtrip changed\n%v\ninto\n%v", keys1[i], keys2[i])
+		}
+	}
+	for _, expected := range []string{
+		`DataPropertyAssertion(:hasName :Diavolo "Teufel"@de)`,
+		`AnnotationAssertion(rdfs:comment :Pizza "a \"round\" dish"^^xsd:string)`,
+		`AnnotationAssertion(rdfs:seeAlso :Pizza <http://en.wikipedia.org/wiki/Pizza>)`,
+		`SubClassOf(:Pizza ObjectMinCardinality(1 :hasBase :PizzaBase))`,
+		`ObjectPropertyAssertion(:hasTopping :Diavolo _:x1)`,
+		`SubAnnotationPropertyOf(:note rdfs:comment)`,
+		`FunctionalObjectProperty(Annotation(rdfs:comment "one base only"^^xsd:string) :hasBase)`,
+	} {
+		if !strings.Contains(text1, expected) {
+			t.Fatalf("missing %v in\n%v", expected, text1)
+		}
+	}
+}
+
+// nodeDocument matches the document of an anonymous individual in a structural key, which differs between two parsed documents.
+var nodeDocument = regexp.MustCompile(`(_:[A-Za-z0-9_.-]+)@[0-9]+`)
+
+// axiomKeys returns the structural key of each axiom in k, with the keys of its annotations, followed by the sorted declarations.
+// The axioms of each kind are in the order of k, which the writer keeps.
+func axiomKeys(k storedefaults.K) (keys []string) {
+	anns := k.(storedefaults.AnnotatedAxioms)
+	for _, x := range []struct {
+		kind storedefaults.AxiomKind
+		all  interface{}
+	}{
+		{storedefaults.KindAnnotationAssertion, k.AllAnnotationAssertions()},
+		{storedefaults.KindAnnotationPropertyDomain, k.AllAnnotationPropertyDomains()},
+		{storedefaults.KindAnnotationPropertyRange, k.AllAnnotationPropertyRanges()},
+		{storedefaults.KindAsymmetricObjectProperty, k.AllAsymmetricObjectProperties()},
+		{storedefaults.KindClassAssertion, k.AllClassAssertions()},
+		{storedefaults.KindDataPropertyAssertion, k.AllDataPropertyAssertions()},
+		{storedefaults.KindDataPropertyDomain, k.AllDataPropertyDomains()},
+		{storedefaults.KindDataPropertyRange, k.AllDataPropertyRanges()},
+		{storedefaults.KindDatatypeDefinition, k.AllDatatypeDefinitions()},
+		{storedefaults.KindDifferentIndividuals, k.AllDifferentIndividuals()},
+		{storedefaults.KindDisjointClasses, k.AllDisjointClasses()},
+		{storedefaults.KindDisjointDataProperties, k.AllDisjointDataProperties()},
+		{storedefaults.KindDisjointObjectProperties, k.AllDisjointObjectProperties()},
+		{storedefaults.KindDisjointUnion, k.AllDisjointUnions()},
+		{storedefaults.KindEquivalentClasses, k.AllEquivalentClasses()},
+		{storedefaults.KindEquivalentDataProperties, k.AllEquivalentDataProperties()},
+		{storedefaults.KindEquivalentObjectProperties, k.AllEquivalentObjectProperties()},
+		{storedefaults.KindFunctionalDataProperty, k.AllFunctionalDataProperties()},
+		{storedefaults.KindFunctionalObjectProperty, k.AllFunctionalObjectProperties()},
+		{storedefaults.KindHasKey, k.AllHasKeys()},
+		{storedefaults.KindInverseFunctionalObjectProperty, k.AllInverseFunctionalObjectProperties()},
+		{storedefaults.KindInverseObjectProperties, k.AllInverseObjectProperties()},
+		{storedefaults.KindIrreflexiveObjectProperty, k.AllIrreflexiveObjectProperties()},
+		{storedefaults.KindNegativeDataPropertyAssertion, k.AllNegativeDataPropertyAssertions()},
+		{storedefaults.KindNegativeObjectPropertyAssertion, k.AllNegativeObjectPropertyAssertions()},
+		{storedefaults.KindObjectPropertyAssertion, k.AllObjectPropertyAssertions()},
+		{storedefaults.KindObjectPropertyDomain, k.AllObjectPropertyDomains()},
+		{storedefaults.KindObjectPropertyRange, k.AllObjectPropertyRanges()},
+		{storedefaults.KindReflexiveObjectProperty, k.AllReflexiveObjectProperties()},
+		{storedefaults.KindSameIndividual, k.AllSameIndividuals()},
+		{storedefaults.KindSubAnnotationPropertyOf, k.AllSubAnnotationPropertyOfs()},
+		{storedefaults.KindSubClassOf, k.AllSubClassOfs()},
+		{storedefaults.KindSubDataPropertyOf, k.AllSubDataPropertyOfs()},
+		{storedefaults.KindSubObjectPropertyChainOf, k.AllSubObjectPropertyChainOfs()},
+		{storedefaults.KindSubObjectPropertyOf, k.AllSubObjectPropertyOfs()},
+		{storedefaults.KindSymmetricObjectProperty, k.AllSymmetricObjectProperties()},
+		{storedefaults.KindTransitiveObjectProperty, k.AllTransitiveObjectProperties()},
+	} {
+		all := reflect.ValueOf(x.all)
+		for i := 0; i < all.Len(); i++ {
+			key := x.kind.String() + " " + structural.Key(all.Index(i).Interface())
+			for _, a := range anns.AxiomAnnotations(x.kind, i) {
+				key += " " + structural.Key(a)
+			}
+			keys = append(keys, nodeDocument.ReplaceAllString(key, "$1"))
+		}
+	}
+
+	var decls []string
+	for _, d := range k.AllAnnotationPropertyDecls() {
+		decls = append(decls, "AnnotationProperty "+d.IRI)
+	}
+	for _, d := range k.AllClassDecls() {
+		decls = append(decls, "Class "+d.IRI)
+	}
+	for _, d := range k.AllDataPropertyDecls() {
+		decls = append(decls, "DataProperty "+d.IRI)
+	}
+	for _, d := range k.AllDatatypeDecls() {
+		decls = append(decls, "Datatype "+d.IRI)
+	}
+	for _, d := range k.AllNamedIndividualDecls() {
+		decls = append(decls, "NamedIndividual "+d.IRI)
+	}
+	for _, d := range k.AllObjectPropertyDecls() {
+		decls = append(decls, "ObjectProperty "+d.IRI)
+	}
+	sort.Strings(decls)
+	return append(keys, decls...)
+}
+
+func TestIRI(t *testing.T) {
+	s := newFw(nil, map[string]string{
+		"":    "http://example.com/a#",
+		"ex":  "http://example.com/",
+		"exa": "http://example.com/a",
+	})
+	for iri, expected := range map[string]string{
+		"http://example.com/a#Pizza":    ":Pizza",
+		"http://example.com/Pizza-2":    "ex:Pizza-2",
+		"http://example.com/a/b":        "<http://example.com/a/b>", // no valid local name with any prefix
+		"http://example.com/Class":      "<http://example.com/Class>",
+		"http://example.com/my-Class":   "<http://example.com/my-Class>",
+		"http://example.com/a#1st":      "<http://example.com/a#1st>",
+		"http://example.com/aPizza":     "exa:Pizza",
+		"urn:other":                     "<urn:other>",
+		"http://example.com/a#Pizza.v2": "<http://example.com/a#Pizza.v2>",
+	} {
+		if s.iri(iri) != expected {
+			t.Fatal(iri, s.iri(iri))
+		}
+	}
+}
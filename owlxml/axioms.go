@@ -0,0 +1,408 @@
+package owlxml
+
+import (
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/tech"
+)
+
+// readAxiom reads a Declaration or any other axiom element, and writes it into the stores.
+func (s *reader) readAxiom(e *element) (err error) {
+	anns, ops, err := s.axiomAnnotations(e)
+	if err != nil {
+		return
+	}
+	st := s.o.AxiomStore
+
+	switch e.name.Local {
+	case "Declaration":
+		err = s.readDeclaration(e, ops)
+
+	// Class Expression Axioms
+	case "SubClassOf":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 2, 2); err == nil {
+			st.StoreSubClassOf(Cs[0], Cs[1], anns)
+		}
+	case "EquivalentClasses":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 2, -1); err == nil {
+			st.StoreEquivalentClasses(Cs, anns)
+		}
+	case "DisjointClasses":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 2, -1); err == nil {
+			st.StoreDisjointClasses(Cs, anns)
+		}
+	case "DisjointUnion":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 3, -1); err != nil {
+			return
+		}
+		if !s.isOWL(ops[0], "Class") || !Cs[0].IsNamedClass() {
+			return s.errorf(ops[0], "1st param in DisjointUnion must be a named class")
+		}
+		st.StoreDisjointUnion(Cs[0], Cs[1:], anns)
+
+	// Object Property Axioms
+	case "SubObjectPropertyOf":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var P meta.ObjectPropertyExpression
+		if P, err = s.objectPropertyExpression(ops[1]); err != nil {
+			return
+		}
+		if s.isOWL(ops[0], "ObjectPropertyChain") {
+			var Chain []meta.ObjectPropertyExpression
+			if Chain, err = s.objectPropertyExpressions(ops[0], ops[0].children, 2); err == nil {
+				st.StoreSubObjectPropertyChainOf(Chain, P, anns)
+			}
+			return
+		}
+		var P1 meta.ObjectPropertyExpression
+		if P1, err = s.objectPropertyExpression(ops[0]); err == nil {
+			st.StoreSubObjectPropertyOf(P1, P, anns)
+		}
+	case "EquivalentObjectProperties":
+		var Ps []meta.ObjectPropertyExpression
+		if Ps, err = s.objectPropertyExpressions(e, ops, 2); err == nil {
+			st.StoreEquivalentObjectProperties(Ps, anns)
+		}
+	case "DisjointObjectProperties":
+		var Ps []meta.ObjectPropertyExpression
+		if Ps, err = s.objectPropertyExpressions(e, ops, 2); err == nil {
+			st.StoreDisjointObjectProperties(Ps, anns)
+		}
+	case "InverseObjectProperties":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var Ps []meta.ObjectPropertyExpression
+		if Ps, err = s.objectPropertyExpressions(e, ops, 2); err == nil {
+			st.StoreInverseObjectProperties(Ps[0], Ps[1], anns)
+		}
+	case "ObjectPropertyDomain", "ObjectPropertyRange":
+		var P meta.ObjectPropertyExpression
+		var C meta.ClassExpression
+		if P, C, err = s.pc(e, ops); err != nil {
+			return
+		}
+		if e.name.Local == "ObjectPropertyDomain" {
+			st.StoreObjectPropertyDomain(P, C, anns)
+		} else {
+			st.StoreObjectPropertyRange(P, C, anns)
+		}
+	case "FunctionalObjectProperty", "InverseFunctionalObjectProperty", "ReflexiveObjectProperty", "IrreflexiveObjectProperty",
+		"SymmetricObjectProperty", "AsymmetricObjectProperty", "TransitiveObjectProperty":
+		err = s.readObjectPropertyCharacteristic(e, ops, anns)
+
+	// Data Property Axioms
+	case "SubDataPropertyOf":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var Rs []meta.DataProperty
+		if Rs, err = s.dataProperties(e, ops, 2); err == nil {
+			st.StoreSubDataPropertyOf(Rs[0], Rs[1], anns)
+		}
+	case "EquivalentDataProperties":
+		var Rs []meta.DataProperty
+		if Rs, err = s.dataProperties(e, ops, 2); err == nil {
+			st.StoreEquivalentDataProperties(Rs, anns)
+		}
+	case "DisjointDataProperties":
+		var Rs []meta.DataProperty
+		if Rs, err = s.dataProperties(e, ops, 2); err == nil {
+			st.StoreDisjointDataProperties(Rs, anns)
+		}
+	case "DataPropertyDomain":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var R meta.DataProperty
+		if R, err = s.dataProperty(ops[0]); err != nil {
+			return
+		}
+		var C meta.ClassExpression
+		if C, err = s.classExpression(ops[1]); err == nil {
+			st.StoreDataPropertyDomain(R, C, anns)
+		}
+	case "DataPropertyRange":
+		var R meta.DataProperty
+		var D meta.DataRange
+		if R, D, err = s.rd(e, ops); err == nil {
+			st.StoreDataPropertyRange(R, D, anns)
+		}
+	case "FunctionalDataProperty":
+		if err = s.wantOperands(e, ops, 1, 1); err != nil {
+			return
+		}
+		var R meta.DataProperty
+		if R, err = s.dataProperty(ops[0]); err == nil {
+			st.StoreFunctionalDataProperty(R, anns)
+		}
+
+	// Datatype Definitions and Keys
+	case "DatatypeDefinition":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var DN meta.NamedDatatype
+		if DN, err = s.namedDatatype(ops[0]); err != nil {
+			return
+		}
+		if _, ok := DN.(*facets.CustomNamedDatatype); !ok {
+			return s.errorf(ops[0], "builtin datatype cannot be redefined in DatatypeDefinition")
+		}
+		var D meta.DataRange
+		if D, err = s.dataRange(ops[1]); err == nil {
+			st.StoreDatatypeDefinition(DN, D, anns)
+		}
+	case "HasKey":
+		err = s.readHasKey(e, ops, anns)
+
+	// Assertions
+	case "SameIndividual":
+		var as []individual.Individual
+		if as, err = s.individuals(e, ops, 2); err == nil {
+			st.StoreSameIndividual(as, anns)
+		}
+	case "DifferentIndividuals":
+		var as []individual.Individual
+		if as, err = s.individuals(e, ops, 2); err == nil {
+			st.StoreDifferentIndividuals(as, anns)
+		}
+	case "ClassAssertion":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var C meta.ClassExpression
+		if C, err = s.classExpression(ops[0]); err != nil {
+			return
+		}
+		var a individual.Individual
+		if a, err = s.individual(ops[1]); err == nil {
+			st.StoreClassAssertion(C, a, anns)
+		}
+	case "ObjectPropertyAssertion", "NegativeObjectPropertyAssertion":
+		if err = s.wantOperands(e, ops, 3, 3); err != nil {
+			return
+		}
+		var P meta.ObjectPropertyExpression
+		if P, err = s.objectPropertyExpression(ops[0]); err != nil {
+			return
+		}
+		var as []individual.Individual
+		if as, err = s.individuals(e, ops[1:], 2); err != nil {
+			return
+		}
+		if e.name.Local == "ObjectPropertyAssertion" {
+			st.StoreObjectPropertyAssertion(P, as[0], as[1], anns)
+		} else {
+			st.StoreNegativeObjectPropertyAssertion(P, as[0], as[1], anns)
+		}
+	case "DataPropertyAssertion", "NegativeDataPropertyAssertion":
+		if err = s.wantOperands(e, ops, 3, 3); err != nil {
+			return
+		}
+		var R meta.DataProperty
+		if R, err = s.dataProperty(ops[0]); err != nil {
+			return
+		}
+		var a individual.Individual
+		if a, err = s.individual(ops[1]); err != nil {
+			return
+		}
+		var v literal.OWLLiteral
+		if v, err = s.literal(ops[2]); err != nil {
+			return
+		}
+		if e.name.Local == "DataPropertyAssertion" {
+			st.StoreDataPropertyAssertion(R, a, v, anns)
+		} else {
+			st.StoreNegativeDataPropertyAssertion(R, a, v, anns)
+		}
+
+	// Annotation Axioms
+	case "AnnotationAssertion":
+		err = s.readAnnotationAssertion(e, ops, anns)
+	case "SubAnnotationPropertyOf":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var A1, A2 string
+		if A1, err = s.annotationPropertyIRI(ops[0]); err != nil {
+			return
+		}
+		if A2, err = s.annotationPropertyIRI(ops[1]); err != nil {
+			return
+		}
+		st.StoreSubAnnotationPropertyOf(A1, A2, anns)
+	case "AnnotationPropertyDomain", "AnnotationPropertyRange":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var A meta.AnnotationProperty
+		if A, err = s.annotationProperty(ops[0]); err != nil {
+			return
+		}
+		var U string
+		if U, err = s.iriValue(ops[1]); err != nil {
+			return
+		}
+		if e.name.Local == "AnnotationPropertyDomain" {
+			st.StoreAnnotationPropertyDomain(A, U, anns)
+		} else {
+			st.StoreAnnotationPropertyRange(A, U, anns)
+		}
+
+	default:
+		err = s.errorf(e, "unexpected ontology element %v", e.name.Local)
+	}
+	return
+}
+
+// readDeclaration stores the declared entity. Like the OWL-Functional parser, it ignores the declarations annotations.
+func (s *reader) readDeclaration(e *element, ops []*element) (err error) {
+	if err = s.wantOperands(e, ops, 1, 1); err != nil {
+		return
+	}
+	entity := ops[0]
+	if entity.name.Space != builtindatatypes.PRE_OWL {
+		return s.errorf(entity, "unexpected element %v in Declaration", entity.name.Local)
+	}
+
+	var ident *tech.IRI
+	ds := s.o.DeclStore
+	switch entity.name.Local {
+	case "AnnotationProperty":
+		if ident, err = s.entityIRI(entity); err == nil {
+			ds.StoreAnnotationPropertyDecl(ident.String())
+		}
+	case "Class":
+		if ident, err = s.entityIRI(entity); err == nil {
+			ds.StoreClassDecl(ident.String())
+		}
+	case "DataProperty":
+		if ident, err = s.entityIRI(entity); err == nil {
+			ds.StoreDataPropertyDecl(ident.String())
+		}
+	case "Datatype":
+		if ident, err = s.entityIRI(entity); err == nil {
+			ds.StoreDatatypeDecl(ident.String())
+		}
+	case "NamedIndividual":
+		if ident, err = s.entityIRI(entity); err == nil {
+			ds.StoreNamedIndividualDecl(ident.String())
+		}
+	case "ObjectProperty":
+		if ident, err = s.entityIRI(entity); err == nil {
+			ds.StoreObjectPropertyDecl(ident.String())
+		}
+	default:
+		err = s.errorf(entity, "unexpected element %v in Declaration", entity.name.Local)
+	}
+	return
+}
+
+func (s *reader) readObjectPropertyCharacteristic(e *element, ops []*element, anns []meta.Annotation) (err error) {
+	if err = s.wantOperands(e, ops, 1, 1); err != nil {
+		return
+	}
+	var P meta.ObjectPropertyExpression
+	if P, err = s.objectPropertyExpression(ops[0]); err != nil {
+		return
+	}
+
+	st := s.o.AxiomStore
+	switch e.name.Local {
+	case "FunctionalObjectProperty":
+		st.StoreFunctionalObjectProperty(P, anns)
+	case "InverseFunctionalObjectProperty":
+		st.StoreInverseFunctionalObjectProperty(P, anns)
+	case "ReflexiveObjectProperty":
+		st.StoreReflexiveObjectProperty(P, anns)
+	case "IrreflexiveObjectProperty":
+		st.StoreIrreflexiveObjectProperty(P, anns)
+	case "SymmetricObjectProperty":
+		st.StoreSymmetricObjectProperty(P, anns)
+	case "AsymmetricObjectProperty":
+		st.StoreAsymmetricObjectProperty(P, anns)
+	case "TransitiveObjectProperty":
+		st.StoreTransitiveObjectProperty(P, anns)
+	}
+	return
+}
+
+// readHasKey reads HasKey, where the class expression is followed by object and data properties in any order.
+func (s *reader) readHasKey(e *element, ops []*element, anns []meta.Annotation) (err error) {
+	if err = s.wantOperands(e, ops, 2, -1); err != nil {
+		return
+	}
+	var C meta.ClassExpression
+	if C, err = s.classExpression(ops[0]); err != nil {
+		return
+	}
+
+	var Ps []meta.ObjectPropertyExpression
+	var Rs []meta.DataProperty
+	for _, op := range ops[1:] {
+		if s.isOWL(op, "DataProperty") {
+			var R meta.DataProperty
+			if R, err = s.dataProperty(op); err != nil {
+				return
+			}
+			Rs = append(Rs, R)
+			continue
+		}
+		var P meta.ObjectPropertyExpression
+		if P, err = s.objectPropertyExpression(op); err != nil {
+			return
+		}
+		Ps = append(Ps, P)
+	}
+	s.o.AxiomStore.StoreHasKey(C, Ps, Rs, anns)
+	return
+}
+
+func (s *reader) readAnnotationAssertion(e *element, ops []*element, anns []meta.Annotation) (err error) {
+	if err = s.wantOperands(e, ops, 3, 3); err != nil {
+		return
+	}
+	var A meta.AnnotationProperty
+	if A, err = s.annotationProperty(ops[0]); err != nil {
+		return
+	}
+	var s_ string
+	if s_, err = s.annotationSubject(ops[1]); err != nil {
+		return
+	}
+	var t string
+	if t, err = s.annotationValue(ops[2]); err != nil {
+		return
+	}
+	var documentID int64
+	if s.isOWL(ops[1], "AnonymousIndividual") || s.isOWL(ops[2], "AnonymousIndividual") {
+		documentID = s.documentID
+	}
+	s.o.AxiomStore.StoreAnnotationAssertion(A, s_, t, documentID, anns)
+	return
+}
+
+// annotationPropertyIRI returns the IRI of an AnnotationProperty element, without resolving it to a declaration.
+// This is how the OWL-Functional parser reads SubAnnotationPropertyOf.
+func (s *reader) annotationPropertyIRI(e *element) (iri string, err error) {
+	if !s.isOWL(e, "AnnotationProperty") {
+		err = s.errorf(e, "expected annotation property, found %v", e.name.Local)
+		return
+	}
+	var ident *tech.IRI
+	if ident, err = s.entityIRI(e); err == nil {
+		iri = ident.String()
+	}
+	return
+}
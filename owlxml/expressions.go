@@ -0,0 +1,755 @@
+package owlxml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/owlfunctional/properties"
+	"github.com/shful/gofp/store"
+	"github.com/shful/gofp/tech"
+)
+
+// entityIRI returns the full IRI of an entity element like <Class IRI="#Pizza"/> or <Class abbreviatedIRI=":Pizza"/>.
+func (s *reader) entityIRI(e *element) (ident *tech.IRI, err error) {
+	var iri string
+	if v, ok := e.attrs["IRI"]; ok {
+		if iri, err = resolveIRI(e.base, v); err != nil {
+			err = s.errorf(e, "invalid IRI (%v):%v", v, err)
+			return
+		}
+	} else if v, ok := e.attrs["abbreviatedIRI"]; ok {
+		if iri, err = s.expand(e, v); err != nil {
+			return
+		}
+	} else {
+		err = s.errorf(e, "missing IRI or abbreviatedIRI attribute in %v", e.name.Local)
+		return
+	}
+	if ident, err = tech.NewIRIFromString(iri); err != nil {
+		err = s.errorf(e, "%v", err)
+	}
+	return
+}
+
+// expand resolves an abbreviated IRI like "xsd:integer" with the prefixes of the ontology.
+func (s *reader) expand(e *element, abbreviated string) (iri string, err error) {
+	i := strings.Index(abbreviated, ":")
+	if i < 0 {
+		err = s.errorf(e, "abbreviated IRI (%v) without prefix", abbreviated)
+		return
+	}
+	resolved, ok := s.o.ResolvePrefix(abbreviated[:i])
+	if !ok {
+		err = s.errorf(e, "unknown prefix (%v) in abbreviated IRI %v", abbreviated[:i], abbreviated)
+		return
+	}
+	iri = resolved + abbreviated[i+1:]
+	return
+}
+
+// iriValue reads an IRI or AbbreviatedIRI element, which has the IRI as text.
+func (s *reader) iriValue(e *element) (iri string, err error) {
+	text := strings.TrimSpace(e.text)
+	switch e.name.Local {
+	case "IRI":
+		if iri, err = resolveIRI(e.base, text); err != nil {
+			err = s.errorf(e, "invalid IRI (%v):%v", text, err)
+		}
+	case "AbbreviatedIRI":
+		iri, err = s.expand(e, text)
+	default:
+		err = s.errorf(e, "unexpected %v, need IRI", e.name.Local)
+	}
+	return
+}
+
+// nodeID returns the node ID of an AnonymousIndividual element, including the leading "_:" like in OWL-Functional.
+func (s *reader) nodeID(e *element) (nodeID string, err error) {
+	nodeID, ok := e.attrs["nodeID"]
+	if !ok || nodeID == "" {
+		err = s.errorf(e, "missing nodeID attribute in AnonymousIndividual")
+		return
+	}
+	if !strings.HasPrefix(nodeID, "_:") {
+		nodeID = "_:" + nodeID
+	}
+	return
+}
+
+// wantOperands returns an error unless e has min..max child elements. max<0 is unlimited.
+func (s *reader) wantOperands(e *element, operands []*element, min, max int) error {
+	n := len(operands)
+	if n < min {
+		return s.errorf(e, "not enough params (%d) in %v, expected >=%d", n, e.name.Local, min)
+	}
+	if max >= 0 && n > max {
+		return s.errorf(e, "too many params (%d) in %v, expected <=%d", n, e.name.Local, max)
+	}
+	return nil
+}
+
+// cardinality reads the cardinality attribute of restrictions like ObjectMinCardinality.
+func (s *reader) cardinality(e *element) (n int, err error) {
+	v := e.attrs["cardinality"]
+	n, err = strconv.Atoi(v)
+	if err != nil || n < 0 {
+		err = s.errorf(e, "need non-negative integer as cardinality in %v, found %q", e.name.Local, v)
+	}
+	return
+}
+
+func (s *reader) classExpression(e *element) (expr meta.ClassExpression, err error) {
+	if e.name.Space != builtindatatypes.PRE_OWL {
+		err = s.errorf(e, "expected class expression, found %v", e.name.Local)
+		return
+	}
+	ops := e.children
+
+	switch e.name.Local {
+	case "Class":
+		var ident *tech.IRI
+		if ident, err = s.entityIRI(e); err != nil {
+			return
+		}
+		if builtindatatypes.IsOWL(*ident) {
+			switch ident.Fragment {
+			case "Thing":
+				expr = &classexpression.OWLThing{}
+			case "Nothing":
+				expr = &classexpression.OWLNothing{}
+			default:
+				err = s.errorf(e, `unexpected OWL name "%v"`, ident.Fragment)
+			}
+			return
+		}
+		var ok bool
+		expr, ok = s.o.Decls.ClassDecl(ident.String())
+		if !ok {
+			err = s.errorf(e, "Unknown ref to %v. Expected class expression.", ident)
+		}
+	// Boolean Conectives and Enumeration of Individuals
+	case "ObjectComplementOf":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 1, 1); err == nil {
+			expr = &classexpression.ObjectComplementOf{C: Cs[0]}
+		}
+	case "ObjectIntersectionOf":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 2, -1); err == nil {
+			expr = &classexpression.ObjectIntersectionOf{Cs: Cs}
+		}
+	case "ObjectUnionOf":
+		var Cs []meta.ClassExpression
+		if Cs, err = s.classExpressions(e, ops, 2, -1); err == nil {
+			expr = &classexpression.ObjectUnionOf{Cs: Cs}
+		}
+	case "ObjectOneOf":
+		var as []individual.Individual
+		if as, err = s.individuals(e, ops, 1); err == nil {
+			expr = &classexpression.ObjectOneOf{As: as}
+		}
+	// Object Property Restrictions
+	case "ObjectAllValuesFrom", "ObjectSomeValuesFrom":
+		var P meta.ObjectPropertyExpression
+		var C meta.ClassExpression
+		if P, C, err = s.pc(e, ops); err != nil {
+			return
+		}
+		if e.name.Local == "ObjectAllValuesFrom" {
+			expr = &classexpression.ObjectAllValuesFrom{P: P, C: C}
+		} else {
+			expr = &classexpression.ObjectSomeValuesFrom{P: P, C: C}
+		}
+	case "ObjectHasValue":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var P meta.ObjectPropertyExpression
+		if P, err = s.objectPropertyExpression(ops[0]); err != nil {
+			return
+		}
+		var a individual.Individual
+		if a, err = s.individual(ops[1]); err != nil {
+			return
+		}
+		expr = &classexpression.ObjectHasValue{P: P, A: a}
+	case "ObjectHasSelf":
+		if err = s.wantOperands(e, ops, 1, 1); err != nil {
+			return
+		}
+		var P meta.ObjectPropertyExpression
+		if P, err = s.objectPropertyExpression(ops[0]); err == nil {
+			expr = &classexpression.ObjectHasSelf{P: P}
+		}
+	case "ObjectExactCardinality", "ObjectMaxCardinality", "ObjectMinCardinality":
+		expr, err = s.objectCardinality(e, ops)
+	// Data Property Restrictions
+	case "DataAllValuesFrom", "DataSomeValuesFrom":
+		var R meta.DataProperty
+		var D meta.DataRange
+		if R, D, err = s.rd(e, ops); err != nil {
+			return
+		}
+		if e.name.Local == "DataAllValuesFrom" {
+			expr = &classexpression.DataAllValuesFrom{R: R, D: D}
+		} else {
+			expr = &classexpression.DataSomeValuesFrom{R: R, D: D}
+		}
+	case "DataHasValue":
+		if err = s.wantOperands(e, ops, 2, 2); err != nil {
+			return
+		}
+		var R meta.DataProperty
+		if R, err = s.dataProperty(ops[0]); err != nil {
+			return
+		}
+		var v literal.OWLLiteral
+		if v, err = s.literal(ops[1]); err != nil {
+			return
+		}
+		expr = &classexpression.DataHasValue{R: R, V: v}
+	case "DataExactCardinality", "DataMaxCardinality", "DataMinCardinality":
+		expr, err = s.dataCardinality(e, ops)
+	default:
+		err = s.errorf(e, "expected class expression, found %v", e.name.Local)
+	}
+	return
+}
+
+// classExpressions reads all ops as class expressions. e is the enclosing element.
+func (s *reader) classExpressions(e *element, ops []*element, min, max int) (Cs []meta.ClassExpression, err error) {
+	if err = s.wantOperands(e, ops, min, max); err != nil {
+		return
+	}
+	for _, op := range ops {
+		var C meta.ClassExpression
+		if C, err = s.classExpression(op); err != nil {
+			return
+		}
+		Cs = append(Cs, C)
+	}
+	return
+}
+
+// pc reads the pair (P,C).
+func (s *reader) pc(e *element, ops []*element) (P meta.ObjectPropertyExpression, C meta.ClassExpression, err error) {
+	if err = s.wantOperands(e, ops, 2, 2); err != nil {
+		return
+	}
+	if P, err = s.objectPropertyExpression(ops[0]); err != nil {
+		return
+	}
+	C, err = s.classExpression(ops[1])
+	return
+}
+
+// rd reads the pair (R,D).
+func (s *reader) rd(e *element, ops []*element) (R meta.DataProperty, D meta.DataRange, err error) {
+	if err = s.wantOperands(e, ops, 2, 2); err != nil {
+		return
+	}
+	if R, err = s.dataProperty(ops[0]); err != nil {
+		return
+	}
+	D, err = s.dataRange(ops[1])
+	return
+}
+
+func (s *reader) objectCardinality(e *element, ops []*element) (expr meta.ClassExpression, err error) {
+	var n int
+	if n, err = s.cardinality(e); err != nil {
+		return
+	}
+	if err = s.wantOperands(e, ops, 1, 2); err != nil {
+		return
+	}
+	var P meta.ObjectPropertyExpression
+	if P, err = s.objectPropertyExpression(ops[0]); err != nil {
+		return
+	}
+	if len(ops) == 1 {
+		switch e.name.Local {
+		case "ObjectExactCardinality":
+			expr = &classexpression.ObjectExactCardinality{N: n, P: P}
+		case "ObjectMaxCardinality":
+			expr = &classexpression.ObjectMaxCardinality{N: n, P: P}
+		case "ObjectMinCardinality":
+			expr = &classexpression.ObjectMinCardinality{N: n, P: P}
+		}
+		return
+	}
+
+	var C meta.ClassExpression
+	if C, err = s.classExpression(ops[1]); err != nil {
+		return
+	}
+	switch e.name.Local {
+	case "ObjectExactCardinality":
+		expr = &classexpression.ObjectQualifiedExactCardinality{N: n, P: P, C: C}
+	case "ObjectMaxCardinality":
+		expr = &classexpression.ObjectQualifiedMaxCardinality{N: n, P: P, C: C}
+	case "ObjectMinCardinality":
+		expr = &classexpression.ObjectQualifiedMinCardinality{N: n, P: P, C: C}
+	}
+	return
+}
+
+func (s *reader) dataCardinality(e *element, ops []*element) (expr meta.ClassExpression, err error) {
+	var n int
+	if n, err = s.cardinality(e); err != nil {
+		return
+	}
+	if err = s.wantOperands(e, ops, 1, 2); err != nil {
+		return
+	}
+	var R meta.DataProperty
+	if R, err = s.dataProperty(ops[0]); err != nil {
+		return
+	}
+	if len(ops) == 1 {
+		switch e.name.Local {
+		case "DataExactCardinality":
+			expr = &classexpression.DataExactCardinality{N: n, R: R}
+		case "DataMaxCardinality":
+			expr = &classexpression.DataMaxCardinality{N: n, R: R}
+		case "DataMinCardinality":
+			expr = &classexpression.DataMinCardinality{N: n, R: R}
+		}
+		return
+	}
+
+	var D meta.DataRange
+	if D, err = s.dataRange(ops[1]); err != nil {
+		return
+	}
+	switch e.name.Local {
+	case "DataExactCardinality":
+		expr = &classexpression.DataQualifiedExactCardinality{N: n, R: R, D: D}
+	case "DataMaxCardinality":
+		expr = &classexpression.DataQualifiedMaxCardinality{N: n, R: R, D: D}
+	case "DataMinCardinality":
+		expr = &classexpression.DataQualifiedMinCardinality{N: n, R: R, D: D}
+	}
+	return
+}
+
+func (s *reader) objectPropertyExpression(e *element) (expr meta.ObjectPropertyExpression, err error) {
+	switch {
+	case s.isOWL(e, "ObjectInverseOf"):
+		if err = s.wantOperands(e, e.children, 1, 1); err != nil {
+			return
+		}
+		op := e.children[0]
+		if !s.isOWL(op, "ObjectProperty") {
+			err = s.errorf(op, "expected object property in ObjectInverseOf, found %v", op.name.Local)
+			return
+		}
+		var ident *tech.IRI
+		if ident, err = s.entityIRI(op); err != nil {
+			return
+		}
+		expr = &properties.ObjectInverseOf{PN: ident.String()}
+	case s.isOWL(e, "ObjectProperty"):
+		var ident *tech.IRI
+		if ident, err = s.entityIRI(e); err != nil {
+			return
+		}
+		if builtindatatypes.IsOWL(*ident) {
+			switch ident.Fragment {
+			case "topObjectProperty":
+				expr = &properties.OWLTopObjectProperty{}
+			case "bottomObjectProperty":
+				expr = &properties.OWLBottomObjectProperty{}
+			default:
+				err = s.errorf(e, `unexpected OWL property "%v"`, ident.Fragment)
+			}
+			return
+		}
+		var ok bool
+		expr, ok = s.o.Decls.ObjectPropertyDecl(ident.String())
+		if !ok {
+			err = s.errorf(e, "Unknown ref to %v. Expected object property name.", ident)
+		}
+	default:
+		err = s.errorf(e, "expected object property expression, found %v", e.name.Local)
+	}
+	return
+}
+
+// objectPropertyExpressions reads all ops as object property expressions. e is the enclosing element.
+func (s *reader) objectPropertyExpressions(e *element, ops []*element, min int) (Ps []meta.ObjectPropertyExpression, err error) {
+	if err = s.wantOperands(e, ops, min, -1); err != nil {
+		return
+	}
+	for _, op := range ops {
+		var P meta.ObjectPropertyExpression
+		if P, err = s.objectPropertyExpression(op); err != nil {
+			return
+		}
+		Ps = append(Ps, P)
+	}
+	return
+}
+
+func (s *reader) dataProperty(e *element) (expr meta.DataProperty, err error) {
+	if !s.isOWL(e, "DataProperty") {
+		err = s.errorf(e, "expected data property, found %v", e.name.Local)
+		return
+	}
+	var ident *tech.IRI
+	if ident, err = s.entityIRI(e); err != nil {
+		return
+	}
+	if builtindatatypes.IsOWL(*ident) {
+		switch ident.Fragment {
+		case "topDataProperty":
+			expr = &properties.OWLTopDataProperty{}
+		case "bottomDataProperty":
+			expr = &properties.OWLBottomDataProperty{}
+		default:
+			err = s.errorf(e, `unexpected OWL property "%v"`, ident.Fragment)
+		}
+		return
+	}
+	var ok bool
+	expr, ok = s.o.Decls.DataPropertyDecl(ident.String())
+	if !ok {
+		err = s.errorf(e, "Unknown ref to %v. Expected datatype property.", ident)
+	}
+	return
+}
+
+// dataProperties reads all ops as data properties. e is the enclosing element.
+func (s *reader) dataProperties(e *element, ops []*element, min int) (Rs []meta.DataProperty, err error) {
+	if err = s.wantOperands(e, ops, min, -1); err != nil {
+		return
+	}
+	for _, op := range ops {
+		var R meta.DataProperty
+		if R, err = s.dataProperty(op); err != nil {
+			return
+		}
+		Rs = append(Rs, R)
+	}
+	return
+}
+
+func (s *reader) annotationProperty(e *element) (expr meta.AnnotationProperty, err error) {
+	if !s.isOWL(e, "AnnotationProperty") {
+		err = s.errorf(e, "expected annotation property, found %v", e.name.Local)
+		return
+	}
+	var ident *tech.IRI
+	if ident, err = s.entityIRI(e); err != nil {
+		return
+	}
+	var ok bool
+	expr, ok = s.o.Decls.AnnotationPropertyDecl(ident.String())
+	if !ok {
+		err = s.errorf(e, "undeclared AnnotationProperty")
+	}
+	return
+}
+
+func (s *reader) dataRange(e *element) (expr meta.DataRange, err error) {
+	if e.name.Space != builtindatatypes.PRE_OWL {
+		err = s.errorf(e, "expected data range, found %v", e.name.Local)
+		return
+	}
+	ops := e.children
+
+	switch e.name.Local {
+	case "Datatype":
+		expr, err = s.namedDatatype(e)
+	case "DataComplementOf":
+		var Ds []meta.DataRange
+		if Ds, err = s.dataRanges(e, ops, 1, 1); err == nil {
+			expr = &dataranges.DataComplementOf{D: Ds[0]}
+		}
+	case "DataIntersectionOf":
+		var Ds []meta.DataRange
+		if Ds, err = s.dataRanges(e, ops, 2, -1); err == nil {
+			expr = &dataranges.DataIntersectionOf{Ds: Ds}
+		}
+	case "DataUnionOf":
+		var Ds []meta.DataRange
+		if Ds, err = s.dataRanges(e, ops, 2, -1); err == nil {
+			expr = &dataranges.DataUnionOf{Ds: Ds}
+		}
+	case "DataOneOf":
+		if err = s.wantOperands(e, ops, 1, -1); err != nil {
+			return
+		}
+		var Vs []literal.OWLLiteral
+		for _, op := range ops {
+			var v literal.OWLLiteral
+			if v, err = s.literal(op); err != nil {
+				return
+			}
+			Vs = append(Vs, v)
+		}
+		expr = &dataranges.DataOneOf{Vs: Vs}
+	case "DatatypeRestriction":
+		var restriction facets.DatatypeRestriction
+		if restriction, err = s.datatypeRestriction(e, ops); err == nil {
+			expr = &restriction
+		}
+	default:
+		err = s.errorf(e, "expected data range, found %v", e.name.Local)
+	}
+	return
+}
+
+// dataRanges reads all ops as data ranges. e is the enclosing element.
+func (s *reader) dataRanges(e *element, ops []*element, min, max int) (Ds []meta.DataRange, err error) {
+	if err = s.wantOperands(e, ops, min, max); err != nil {
+		return
+	}
+	for _, op := range ops {
+		var D meta.DataRange
+		if D, err = s.dataRange(op); err != nil {
+			return
+		}
+		Ds = append(Ds, D)
+	}
+	return
+}
+
+func (s *reader) datatypeRestriction(e *element, ops []*element) (expr facets.DatatypeRestriction, err error) {
+	if err = s.wantOperands(e, ops, 1, -1); err != nil {
+		return
+	}
+	var DN meta.NamedDatatype
+	if DN, err = s.namedDatatype(ops[0]); err != nil {
+		return
+	}
+
+	var fvPairs []*facets.FVPair
+	for _, op := range ops[1:] {
+		if !s.isOWL(op, "FacetRestriction") {
+			err = s.errorf(op, "expected FacetRestriction, found %v", op.name.Local)
+			return
+		}
+		facetIRI, err := resolveIRI(op.base, op.attrs["facet"])
+		if err != nil {
+			return expr, s.errorf(op, "invalid facet IRI:%v", err)
+		}
+		f, ok := facets.FacetByIRI(facetIRI)
+		if !ok {
+			return expr, s.errorf(op, "expected known facet, found %v.", facetIRI)
+		}
+		if err = s.wantOperands(op, op.children, 1, 1); err != nil {
+			return expr, err
+		}
+		v, err := s.literal(op.children[0])
+		if err != nil {
+			return expr, err
+		}
+		fvPairs = append(fvPairs, &facets.FVPair{F: f, V: v})
+	}
+
+	expr = facets.DatatypeRestriction{DN: DN, FVPairs: fvPairs}
+	return
+}
+
+// namedDatatype resolves a Datatype element like parsefuncs.ParseNamedDatatype does:
+// a builtin datatype, a custom datatype with a DatatypeDefinition, or a declared custom datatype.
+func (s *reader) namedDatatype(e *element) (expr meta.NamedDatatype, err error) {
+	if !s.isOWL(e, "Datatype") {
+		err = s.errorf(e, "expected Datatype, found %v", e.name.Local)
+		return
+	}
+	var ident *tech.IRI
+	if ident, err = s.entityIRI(e); err != nil {
+		return
+	}
+
+	// Builtin Datatype IRI is allowed:
+	if builtindatatypes.BuiltinDatatypeExists(ident.String()) {
+		expr = &facets.BuiltinDatatype{NamedDatatypeImpl: facets.NamedDatatypeImpl{DatatypeIRI: ident.String()}}
+		return
+	}
+
+	// Defined Datatype IRI is allowed. The definition is resolved when needed, since it may follow in the input:
+	definitions, _ := s.o.Decls.(store.DatatypeDefinitions)
+	if definitions != nil {
+		if _, ok := definitions.DatatypeDefinition(ident.String()); ok {
+			expr = facets.NewCustomNamedDatatype(ident.String(), definitions)
+			return
+		}
+	}
+
+	// Declared Datatype IRI is allowed:
+	if _, ok := s.o.Decls.DatatypeDecl(ident.String()); ok {
+		expr = facets.NewCustomNamedDatatype(ident.String(), definitions)
+		return
+	}
+
+	err = s.errorf(e, "unknown datatype literal (%v)", ident)
+	return
+}
+
+// individual reads a NamedIndividual, resolved to its declaration, or an AnonymousIndividual.
+func (s *reader) individual(e *element) (a individual.Individual, err error) {
+	switch {
+	case s.isOWL(e, "AnonymousIndividual"):
+		var nodeID string
+		if nodeID, err = s.nodeID(e); err == nil {
+			a = individual.NewAnonymous(nodeID, s.documentID)
+		}
+	case s.isOWL(e, "NamedIndividual"):
+		var ident *tech.IRI
+		if ident, err = s.entityIRI(e); err != nil {
+			return
+		}
+		d, ok := s.o.Decls.NamedIndividualDecl(ident.String())
+		if !ok {
+			err = s.errorf(e, "Unknown ref to %v. Expected individual.", ident)
+			return
+		}
+		a = individual.NewNamed(d)
+	default:
+		err = s.errorf(e, "expected individual, found %v", e.name.Local)
+	}
+	return
+}
+
+// individuals reads all ops as individuals. e is the enclosing element.
+func (s *reader) individuals(e *element, ops []*element, min int) (as []individual.Individual, err error) {
+	if err = s.wantOperands(e, ops, min, -1); err != nil {
+		return
+	}
+	for _, op := range ops {
+		var a individual.Individual
+		if a, err = s.individual(op); err != nil {
+			return
+		}
+		as = append(as, a)
+	}
+	return
+}
+
+// literal reads a Literal element into the same OWLLiteral which the OWL-Functional parser makes of it.
+// The Value is escaped as in OWL-Functional. A literal without datatype, or with rdf:PlainLiteral, becomes xsd:string.
+func (s *reader) literal(e *element) (l literal.OWLLiteral, err error) {
+	if !s.isOWL(e, "Literal") {
+		err = s.errorf(e, "expected Literal, found %v", e.name.Local)
+		return
+	}
+	if len(e.children) > 0 {
+		err = s.errorf(e, "unexpected element %v in Literal", e.children[0].name.Local)
+		return
+	}
+
+	literaltype := builtindatatypes.PRE_XSD + "string"
+	if v, ok := e.attrs["datatypeIRI"]; ok && v != builtindatatypes.PRE_RDF+"PlainLiteral" {
+		if literaltype, err = resolveIRI(e.base, v); err != nil {
+			err = s.errorf(e, "invalid datatypeIRI:%v", err)
+			return
+		}
+	}
+
+	if err = literaltypeMismatch(e.text, literaltype); err != nil {
+		err = s.errorf(e, "parsing literal:%v", err)
+		return
+	}
+
+	l = literal.OWLLiteral{
+		Value:       strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(e.text),
+		Literaltype: literaltype,
+		LangTag:     e.attrs["xml:lang"],
+	}
+	return
+}
+
+// literaltypeMismatch returns an error if value does not fit to a builtin numeric literaltype, like "abc" for xsd:integer.
+func literaltypeMismatch(value, literaltype string) (err error) {
+	switch builtindatatypes.BuiltinDatatypes[literaltype] {
+	case parser.INTLIT:
+		_, err = strconv.Atoi(value)
+	case parser.FLOATLIT:
+		_, err = strconv.ParseFloat(value, 64)
+	}
+	if err != nil {
+		err = fmt.Errorf("literal type mismatch with value (%v)", literaltype)
+	}
+	return
+}
+
+// annotation reads an Annotation element. Annotations of the annotation itself are skipped.
+func (s *reader) annotation(e *element) (expr *annotations.Annotation, err error) {
+	_, ops := splitAnnotations(e)
+	if err = s.wantOperands(e, ops, 2, 2); err != nil {
+		return
+	}
+	var A meta.AnnotationProperty
+	if A, err = s.annotationProperty(ops[0]); err != nil {
+		return
+	}
+	var t string
+	if t, err = s.annotationValue(ops[1]); err != nil {
+		return
+	}
+	if s.isOWL(ops[1], "AnonymousIndividual") {
+		expr = annotations.NewAnonymousAnnotation(A, t, s.documentID)
+		return
+	}
+	expr = annotations.NewAnnotation(A, t)
+	return
+}
+
+// axiomAnnotations reads the annotations which an axiom element starts with.
+// The remaining child elements are returned as ops.
+func (s *reader) axiomAnnotations(e *element) (anns []meta.Annotation, ops []*element, err error) {
+	var annElements []*element
+	annElements, ops = splitAnnotations(e)
+	for _, annElement := range annElements {
+		var anno *annotations.Annotation
+		if anno, err = s.annotation(annElement); err != nil {
+			return
+		}
+		anns = append(anns, anno)
+	}
+	return
+}
+
+// splitAnnotations separates the leading Annotation children of e from the other children.
+func splitAnnotations(e *element) (anns, ops []*element) {
+	i := 0
+	for i < len(e.children) && e.children[i].name.Space == builtindatatypes.PRE_OWL && e.children[i].name.Local == "Annotation" {
+		i++
+	}
+	return e.children[:i], e.children[i:]
+}
+
+// annotationSubject reads an IRI or anonymous individual, which is shortened as "s" in the OWL spec.
+// The result is encoded like parsefuncs.Parses does it.
+func (s *reader) annotationSubject(e *element) (expr string, err error) {
+	if s.isOWL(e, "AnonymousIndividual") {
+		return s.nodeID(e)
+	}
+	return s.iriValue(e)
+}
+
+// annotationValue reads an IRI, literal or anonymous individual, which is shortened as "t" in the OWL spec.
+// The result is encoded like parsefuncs.Parset does it.
+func (s *reader) annotationValue(e *element) (expr string, err error) {
+	if s.isOWL(e, "Literal") {
+		var l literal.OWLLiteral
+		if l, err = s.literal(e); err == nil {
+			expr = l.LiteralString()
+		}
+		return
+	}
+	return s.annotationSubject(e)
+}
@@ -0,0 +1,248 @@
+// owlxml reads OWL/XML documents, as specified in https://www.w3.org/TR/owl2-xml-serialization/
+// The reader drives the same store interfaces as the OWL-Functional parser, so that
+// storedefaults.K and custom stores work unchanged, regardless of the input format.
+package owlxml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/store"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// OntologyFromReader parses OWL/XML contents into an Ontology struct, using the default stores.
+// It is the OWL/XML counterpart of gofp.OntologyFromReader: implicit declarations are accepted, and Ontology.K is set.
+// sourceName is shown in error messages, see parser.NewParser().
+func OntologyFromReader(r io.Reader, sourceName string) (ontology *owlfunctional.Ontology, err error) {
+	k := storedefaults.NewDefaultK()
+	k.ExplicitDecls = false
+
+	rc := owlfunctional.StoreConfig{
+		AxiomStore: k,
+		Decls:      k,
+		DeclStore:  k,
+	}
+	ontology, err = OntologyFromXML(r, sourceName, rc)
+	if err != nil {
+		return
+	}
+	ontology.K = k
+	return
+}
+
+// OntologyFromXML reads the OWL/XML contents r into an Ontology.
+// Like gofp.OntologyFromParser, all declarations and axioms are written into the stores of rc,
+// in the order of the document.
+func OntologyFromXML(r io.Reader, sourceName string, rc owlfunctional.StoreConfig) (ontology *owlfunctional.Ontology, err error) {
+	var root *element
+	root, err = readElements(r, sourceName)
+	if err != nil {
+		return
+	}
+
+	s := &reader{
+		sourceName: sourceName,
+		documentID: parser.NewDocumentID(),
+	}
+	if !s.isOWL(root, "Ontology") {
+		err = s.errorf(root, "unexpected root element %v, need owl:Ontology", root.name.Local)
+		return
+	}
+
+	s.o = owlfunctional.NewOntology(map[string]string{}, rc)
+	err = s.readOntology(root)
+	ontology = s.o
+	return
+}
+
+// element is a node in the XML document tree.
+type element struct {
+	name  xml.Name
+	attrs map[string]string
+
+	// base is the xml:base in scope, which resolves relative IRIs.
+	base string
+
+	// text is the character data directly contained in the element.
+	text     string
+	children []*element
+
+	// line and col are the position after the start tag, for error messages.
+	line, col int
+}
+
+// readElements reads the whole XML document into a tree of elements, and returns the root element.
+func readElements(r io.Reader, sourceName string) (root *element, err error) {
+	d := xml.NewDecoder(r)
+	var stack []*element
+
+	for {
+		var tok xml.Token
+		tok, err = d.Token()
+		if err == io.EOF {
+			err = nil
+			break
+		}
+		if err != nil {
+			line, col := d.InputPos()
+			err = fmt.Errorf("%v in:%v %d:%d", err, sourceName, line, col)
+			return
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			e := &element{name: tok.Name, attrs: map[string]string{}}
+			e.line, e.col = d.InputPos()
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, e)
+				e.base = parent.base
+			} else {
+				root = e
+			}
+			for _, attr := range tok.Attr {
+				name := attr.Name.Local
+				if attr.Name.Space == builtindatatypes.PRE_XML {
+					name = "xml:" + name
+				}
+				e.attrs[name] = attr.Value
+			}
+			if base, ok := e.attrs["xml:base"]; ok {
+				if e.base, err = resolveIRI(e.base, base); err != nil {
+					err = fmt.Errorf("invalid xml:base (%v) in:%v %d:%d", base, sourceName, e.line, e.col)
+					return
+				}
+			}
+			stack = append(stack, e)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text += string(tok)
+			}
+		}
+	}
+
+	if root == nil {
+		err = fmt.Errorf("no XML element found in:%v", sourceName)
+	}
+	return
+}
+
+// resolveIRI resolves iri against base, if iri is relative and a base is given.
+func resolveIRI(base, iri string) (string, error) {
+	if base == "" {
+		return iri, nil
+	}
+	ref, err := url.Parse(iri)
+	if err != nil {
+		return "", err
+	}
+	if ref.IsAbs() {
+		return iri, nil
+	}
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+	return b.ResolveReference(ref).String(), nil
+}
+
+// reader holds the state while one OWL/XML document is read.
+type reader struct {
+	o          *owlfunctional.Ontology
+	sourceName string
+
+	// documentID scopes the node IDs of anonymous individuals, like parser.Parser.DocumentID.
+	documentID int64
+}
+
+// errorf returns an error with the position of e, formatted like gofp.ErrorMsgWithPosition.
+func (s *reader) errorf(e *element, msg string, fmtargs ...interface{}) error {
+	return fmt.Errorf("%v in:%v %d:%d", fmt.Sprintf(msg, fmtargs...), s.sourceName, e.line, e.col)
+}
+
+// isOWL is true if e is the element with the local name in the OWL namespace.
+func (s *reader) isOWL(e *element, name string) bool {
+	return e.name.Space == builtindatatypes.PRE_OWL && e.name.Local == name
+}
+
+// readOntology reads all children of the Ontology element.
+func (s *reader) readOntology(e *element) (err error) {
+	if iri, ok := e.attrs["ontologyIRI"]; ok {
+		if iri, err = resolveIRI(e.base, iri); err != nil {
+			return s.errorf(e, "invalid ontologyIRI:%v", err)
+		}
+		s.o.IRI = "<" + iri + ">"
+	}
+	if iri, ok := e.attrs["versionIRI"]; ok {
+		if iri, err = resolveIRI(e.base, iri); err != nil {
+			return s.errorf(e, "invalid versionIRI:%v", err)
+		}
+		s.o.VERSIONIRI = "<" + iri + ">"
+	}
+
+	for _, child := range e.children {
+		if child.name.Space != builtindatatypes.PRE_OWL {
+			return s.errorf(child, "unexpected element %v in Ontology", child.name.Local)
+		}
+		switch child.name.Local {
+		case "Prefix":
+			err = s.readPrefix(child)
+		case "Import":
+			err = s.readImport(child)
+		case "Annotation":
+			err = s.readOntologyAnnotation(child)
+		default:
+			err = s.readAxiom(child)
+		}
+		if err != nil {
+			return
+		}
+		if f, ok := s.o.AxiomStore.(store.Failer); ok {
+			if err = f.Err(); err != nil {
+				return s.errorf(child, "storing %v:%v", child.name.Local, err)
+			}
+		}
+	}
+	return
+}
+
+func (s *reader) readPrefix(e *element) (err error) {
+	name := e.attrs["name"]
+	iri, ok := e.attrs["IRI"]
+	if !ok {
+		return s.errorf(e, "missing IRI attribute in Prefix")
+	}
+	if _, ok := s.o.Prefixes[name]; ok {
+		return s.errorf(e, `second occurrence of prefix "%v"`, name)
+	}
+	s.o.Prefixes[name] = iri
+	return
+}
+
+// readImport appends the IRI to the ontologies Imports. The imported ontology itself is not loaded here.
+func (s *reader) readImport(e *element) (err error) {
+	var iri string
+	if iri, err = resolveIRI(e.base, strings.TrimSpace(e.text)); err != nil {
+		return s.errorf(e, "invalid IRI in Import:%v", err)
+	}
+	s.o.Imports = append(s.o.Imports, iri)
+	return
+}
+
+func (s *reader) readOntologyAnnotation(e *element) (err error) {
+	anno, err := s.annotation(e)
+	if err != nil {
+		return
+	}
+	s.o.AddAnnotation(*anno)
+	return
+}
@@ -0,0 +1,254 @@
+package owlxml
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/writer"
+	"github.com/shful/gofp/storedefaults"
+)
+
+const pizzaXML = `<?xml version="1.0"?>
+<Ontology xmlns="http://www.w3.org/2002/07/owl#"
+	xml:base="http://example.com/pizza"
+	ontologyIRI="http://example.com/pizza"
+	versionIRI="http://example.com/pizza/1.0">
+	<Prefix name="" IRI="http://example.com/pizza#"/>
+	<Prefix name="rdfs" IRI="http://www.w3.org/2000/01/rdf-schema#"/>
+	<Prefix name="xsd" IRI="http://www.w3.org/2001/XMLSchema#"/>
+	<Prefix name="owl" IRI="http://www.w3.org/2002/07/owl#"/>
+	<Import>http://example.com/food</Import>
+	<Annotation>
+		<AnnotationProperty abbreviatedIRI="rdfs:comment"/>
+		<Literal xml:lang="en">Pizzas</Literal>
+	</Annotation>
+	<Declaration>
+		<Class IRI="#Pizza"/>
+	</Declaration>
+	<Declaration>
+		<Datatype abbreviatedIRI=":calories"/>
+	</Declaration>
+	<DatatypeDefinition>
+		<Datatype abbreviatedIRI=":calories"/>
+		<DatatypeRestriction>
+			<Datatype abbreviatedIRI="xsd:integer"/>
+			<FacetRestriction facet="http://www.w3.org/2001/XMLSchema#minInclusive">
+				<Literal datatypeIRI="http://www.w3.org/2001/XMLSchema#integer">0</Literal>
+			</FacetRestriction>
+		</DatatypeRestriction>
+	</DatatypeDefinition>
+	<SubClassOf>
+		<Annotation>
+			<AnnotationProperty abbreviatedIRI="rdfs:comment"/>
+			<Literal>axiom annotation</Literal>
+		</Annotation>
+		<Class IRI="#Margherita"/>
+		<ObjectIntersectionOf>
+			<Class IRI="#Pizza"/>
+			<ObjectSomeValuesFrom>
+				<ObjectProperty IRI="#hasTopping"/>
+				<Class IRI="#Tomato"/>
+			</ObjectSomeValuesFrom>
+		</ObjectIntersectionOf>
+	</SubClassOf>
+	<SubClassOf>
+		<Class IRI="#Pizza"/>
+		<ObjectMinCardinality cardinality="1">
+			<ObjectProperty IRI="#hasBase"/>
+			<Class IRI="#PizzaBase"/>
+		</ObjectMinCardinality>
+	</SubClassOf>
+	<SubClassOf>
+		<Class IRI="#Pizza"/>
+		<DataExactCardinality cardinality="1">
+			<DataProperty IRI="#hasCalories"/>
+		</DataExactCardinality>
+	</SubClassOf>
+	<SubClassOf>
+		<Class IRI="#Pizza"/>
+		<ObjectAllValuesFrom>
+			<ObjectInverseOf>
+				<ObjectProperty IRI="#isBaseOf"/>
+			</ObjectInverseOf>
+			<Class abbreviatedIRI="owl:Thing"/>
+		</ObjectAllValuesFrom>
+	</SubClassOf>
+	<EquivalentClasses>
+		<Class IRI="#Spicy"/>
+		<ObjectOneOf>
+			<NamedIndividual IRI="#Diavolo"/>
+			<AnonymousIndividual nodeID="x1"/>
+		</ObjectOneOf>
+	</EquivalentClasses>
+	<EquivalentClasses>
+		<Class IRI="#Light"/>
+		<DataSomeValuesFrom>
+			<DataProperty IRI="#hasCalories"/>
+			<DataUnionOf>
+				<DataOneOf>
+					<Literal datatypeIRI="http://www.w3.org/2001/XMLSchema#integer">100</Literal>
+				</DataOneOf>
+				<Datatype abbreviatedIRI=":calories"/>
+			</DataUnionOf>
+		</DataSomeValuesFrom>
+	</EquivalentClasses>
+	<DisjointUnion>
+		<Class IRI="#Topping"/>
+		<Class IRI="#Tomato"/>
+		<Class IRI="#Chili"/>
+	</DisjointUnion>
+	<SubObjectPropertyOf>
+		<ObjectPropertyChain>
+			<ObjectProperty IRI="#hasBase"/>
+			<ObjectProperty IRI="#hasIngredient"/>
+		</ObjectPropertyChain>
+		<ObjectProperty IRI="#hasIngredient"/>
+	</SubObjectPropertyOf>
+	<TransitiveObjectProperty>
+		<ObjectProperty IRI="#hasIngredient"/>
+	</TransitiveObjectProperty>
+	<DataPropertyRange>
+		<DataProperty IRI="#hasName"/>
+		<Datatype abbreviatedIRI="xsd:string"/>
+	</DataPropertyRange>
+	<HasKey>
+		<Class IRI="#Pizza"/>
+		<DataProperty IRI="#hasName"/>
+		<ObjectProperty IRI="#hasBase"/>
+	</HasKey>
+	<ClassAssertion>
+		<Class IRI="#Pizza"/>
+		<NamedIndividual IRI="#Diavolo"/>
+	</ClassAssertion>
+	<ObjectPropertyAssertion>
+		<ObjectProperty IRI="#hasTopping"/>
+		<NamedIndividual IRI="#Diavolo"/>
+		<AnonymousIndividual nodeID="x1"/>
+	</ObjectPropertyAssertion>
+	<DataPropertyAssertion>
+		<DataProperty IRI="#hasName"/>
+		<NamedIndividual IRI="#Diavolo"/>
+		<Literal xml:lang="de">Teufel</Literal>
+	</DataPropertyAssertion>
+	<AnnotationAssertion>
+		<AnnotationProperty abbreviatedIRI="rdfs:comment"/>
+		<IRI>#Pizza</IRI>
+		<Literal>a "round" dish</Literal>
+	</AnnotationAssertion>
+	<AnnotationAssertion>
+		<AnnotationProperty abbreviatedIRI="rdfs:seeAlso"/>
+		<AbbreviatedIRI>:Pizza</AbbreviatedIRI>
+		<IRI>http://en.wikipedia.org/wiki/Pizza</IRI>
+	</AnnotationAssertion>
+	<SubAnnotationPropertyOf>
+		<AnnotationProperty IRI="#note"/>
+		<AnnotationProperty abbreviatedIRI="rdfs:comment"/>
+	</SubAnnotationPropertyOf>
+</Ontology>
+`
+
+// pizzaFunctional is pizzaXML in OWL-Functional syntax.
+const pizzaFunctional = `Prefix(:=<http://example.com/pizza#>)
+Prefix(rdfs:=<http://www.w3.org/2000/01/rdf-schema#>)
+Prefix(xsd:=<http://www.w3.org/2001/XMLSchema#>)
+Prefix(owl:=<http://www.w3.org/2002/07/owl#>)
+
+Ontology(<http://example.com/pizza> <http://example.com/pizza/1.0>
+	Import(<http://example.com/food>)
+	Annotation(rdfs:comment "Pizzas"@en)
+	Declaration(Class(:Pizza))
+	Declaration(Datatype(:calories))
+	DatatypeDefinition(:calories DatatypeRestriction(xsd:integer xsd:minInclusive "0"^^xsd:integer))
+	SubClassOf(Annotation(rdfs:comment "axiom annotation") :Margherita ObjectIntersectionOf(:Pizza ObjectSomeValuesFrom(:hasTopping :Tomato)))
+	SubClassOf(:Pizza ObjectMinCardinality(1 :hasBase :PizzaBase))
+	SubClassOf(:Pizza DataExactCardinality(1 :hasCalories))
+	SubClassOf(:Pizza ObjectAllValuesFrom(ObjectInverseOf(:isBaseOf) owl:Thing))
+	EquivalentClasses(:Spicy ObjectOneOf(:Diavolo _:x1))
+	EquivalentClasses(:Light DataSomeValuesFrom(:hasCalories DataUnionOf(DataOneOf("100"^^xsd:integer) :calories)))
+	DisjointUnion(:Topping :Tomato :Chili)
+	SubObjectPropertyOf(ObjectPropertyChain(:hasBase :hasIngredient) :hasIngredient)
+	TransitiveObjectProperty(:hasIngredient)
+	DataPropertyRange(:hasName xsd:string)
+	HasKey(:Pizza (:hasBase) (:hasName))
+	ClassAssertion(:Pizza :Diavolo)
+	ObjectPropertyAssertion(:hasTopping :Diavolo _:x1)
+	DataPropertyAssertion(:hasName :Diavolo "Teufel"@de)
+	AnnotationAssertion(rdfs:comment :Pizza "a \"round\" dish")
+	AnnotationAssertion(rdfs:seeAlso :Pizza <http://en.wikipedia.org/wiki/Pizza>)
+	SubAnnotationPropertyOf(:note rdfs:comment)
+)
+`
+
+func TestOntologyFromReader(t *testing.T) {
+	o, err := OntologyFromReader(strings.NewReader(pizzaXML), "pizza.owx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.IRI != "<http://example.com/pizza>" || o.VERSIONIRI != "<http://example.com/pizza/1.0>" {
+		t.Fatal(o.IRI, o.VERSIONIRI)
+	}
+	if len(o.Imports) != 1 || o.Imports[0] != "http://example.com/food" {
+		t.Fatal(o.Imports)
+	}
+	if len(o.Annotations()) != 1 || o.Annotations()[0].T() != `"Pizzas"@en^^http://www.w3.org/2001/XMLSchema#string` {
+		t.Fatal(o.Annotations())
+	}
+	if _, ok := o.K.ClassDecl("http://example.com/pizza#Margherita"); !ok {
+		t.Fatal("implicit class declaration missing")
+	}
+
+	f, err := gofp.OntologyFromReader(strings.NewReader(pizzaFunctional), "pizza.owl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := writer.String(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := writer.String(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != expected {
+		t.Fatalf("OWL/XML read differently than OWL-Functional:\n%v\n---\n%v", got, expected)
+	}
+}
+
+func TestOntologyFromXMLWithExplicitDecls(t *testing.T) {
+	k := storedefaults.NewDefaultK()
+	k.ExplicitDecls = true
+	rc := owlfunctional.StoreConfig{AxiomStore: k, Decls: k, DeclStore: k}
+
+	_, err := OntologyFromXML(strings.NewReader(`<Ontology xmlns="http://www.w3.org/2002/07/owl#">
+	<Declaration><Class IRI="http://example.com/Pizza"/></Declaration>
+	<SubClassOf>
+		<Class IRI="http://example.com/Pizza"/>
+		<Class IRI="http://example.com/Food"/>
+	</SubClassOf>
+</Ontology>`), "test", rc)
+	if err == nil || !strings.Contains(err.Error(), "Unknown ref to http://example.com/Food") || !strings.Contains(err.Error(), "test 5:") {
+		t.Fatal(err)
+	}
+	if len(k.AllClassDecls()) != 1 || len(k.AllSubClassOfs()) != 0 {
+		t.Fatal(k.AllClassDecls(), k.AllSubClassOfs())
+	}
+}
+
+func TestOntologyFromXMLErrors(t *testing.T) {
+	for _, doc := range []string{
+		`<Ontology/>`, // not in the OWL namespace
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#"><SubClassOf><Class IRI="a"/></SubClassOf></Ontology>`,
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#"><SubClassOf><Class abbreviatedIRI="x:A"/><Class IRI="b"/></SubClassOf></Ontology>`,
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#"><ClassAssertion><Class IRI="a"/><NamedIndividual IRI="i"/></ClassAssertion><Unknown/></Ontology>`,
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#"><DataHasValue/></Ontology>`,
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#"><DataPropertyAssertion><DataProperty IRI="r"/><NamedIndividual IRI="i"/><Literal datatypeIRI="http://www.w3.org/2001/XMLSchema#integer">x</Literal></DataPropertyAssertion></Ontology>`,
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#"><ObjectMinCardinality cardinality="-1"/></Ontology>`,
+		`<Ontology xmlns="http://www.w3.org/2002/07/owl#">`,
+	} {
+		if _, err := OntologyFromReader(strings.NewReader(doc), "test"); err == nil {
+			t.Fatal("expected error for", doc)
+		}
+	}
+}
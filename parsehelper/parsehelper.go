@@ -36,17 +36,17 @@ func ParseAndResolveIRI(p *parser.Parser, prefixes tech.Prefixes) (ident *tech.I
 		var ok bool
 		head, ok = prefixes.ResolvePrefix(prefix)
 		if !ok {
-			err = pos.Errorf("unknown prefix %v", prefix)
+			err = pos.ErrorfKind(parser.ErrUnknownPrefix, "unknown prefix %v", prefix)
 			return
 		}
 
 		ident, err = tech.NewIRIFromString(head + name)
 		if err != nil {
-			err = pos.Errorf("prefixed name (%v:%v) resolved to invalid IRI (%v)", prefix, name, head+name)
+			err = pos.ErrorfKind(parser.ErrInvalidIRI, "prefixed name (%v:%v) resolved to invalid IRI (%v)", prefix, name, head+name)
 			return
 		}
 	default:
-		err = pos.Errorf("unexpected \"%v\" - need IRI, or prefixed name, or _ for anonymous individual.", lit)
+		err = pos.ErrorfExpected(tok, "IRI or prefixed name", "unexpected \"%v\" - need IRI or prefixed name.", lit)
 	}
 
 	return
@@ -67,13 +67,13 @@ func ParsePrefixedName(p *parser.Parser) (prefix, name string, err error) {
 		// :classname
 		prefix = ""
 	} else {
-		err = pos.Errorf("unexpected \"%v\" - need prefixed name", lit)
+		err = pos.ErrorfExpected(tok, "prefixed name", "unexpected \"%v\" - need prefixed name", lit)
 		return
 	}
 
 	tok, name, pos = p.ScanIgnoreWSAndComment()
 	if tok != parser.IDENT {
-		err = pos.Errorf("unexpected \"%v\" - need identifier in prefixed name", lit)
+		err = pos.ErrorfExpected(tok, parser.Tokenname(parser.IDENT), "unexpected \"%v\" - need identifier in prefixed name", lit)
 	}
 	name += prolongIDENT(p)
 
@@ -108,12 +108,12 @@ func ParseUnprefixedIRI(p *parser.Parser) (iri string, err error) {
 	tok, lit, pos := p.ScanIgnoreWSAndComment()
 	if tok == parser.IRI {
 		if !(strings.HasPrefix(lit, "<") && strings.HasSuffix(lit, ">")) {
-			err = pos.Errorf("expected IRI, but missing < and > on the ends (found:%v)", lit)
+			err = pos.ErrorfKind(parser.ErrInvalidIRI, "expected IRI, but missing < and > on the ends (found:%v)", lit)
 		} else {
 			iri = lit[1 : len(lit)-1]
 		}
 	} else {
-		err = pos.Errorf("expected IRI, but found:%v", parser.DescribeToklit(tok, lit))
+		err = pos.ErrorfExpected(tok, "IRI", "expected IRI, but found:%v", parser.DescribeToklit(tok, lit))
 	}
 	return
 }
@@ -127,22 +127,23 @@ func ParseIRIWithFragment(p *parser.Parser) (head, fragment string, err error) {
 	tok, iri, pos := p.ScanIgnoreWSAndComment()
 	if tok == parser.IRI {
 		if !(strings.HasPrefix(iri, "<") && strings.HasSuffix(iri, ">")) {
-			err = pos.Errorf("expected IRI, but missing < and > on the ends (found:%v)", iri)
+			err = pos.ErrorfKind(parser.ErrInvalidIRI, "expected IRI, but missing < and > on the ends (found:%v)", iri)
 			return
 		}
 		if len(iri) == 2 {
-			err = pos.Errorf("empty IRI between <>")
+			err = pos.ErrorfKind(parser.ErrInvalidIRI, "empty IRI between <>")
 			return
 		}
 		var u *url.URL
 		u, err = url.Parse(iri[1 : len(iri)-1])
 		if err != nil {
+			err = pos.ErrorfKind(parser.ErrInvalidIRI, "invalid IRI %v (%v)", iri, err)
 			return
 		}
 		fragment = u.Fragment
 		head = iri[1 : len(iri)-1-len(fragment)] // everything until, and including, the fragments "#"
 	} else {
-		err = pos.Errorf("expected IRI, but found:%v", parser.DescribeToklit(tok, iri))
+		err = pos.ErrorfExpected(tok, "IRI", "expected IRI, but found:%v", parser.DescribeToklit(tok, iri))
 	}
 	return
 }
@@ -151,7 +152,7 @@ func ParseIRIWithFragment(p *parser.Parser) (head, fragment string, err error) {
 func ParseNonNegativeInteger(p *parser.Parser) (res int, err error) {
 	tok, lit, pos := p.ScanIgnoreWSAndComment()
 	if tok != parser.INTLIT {
-		err = pos.Errorf("int literal needed, found %v", lit)
+		err = pos.ErrorfExpected(tok, "int literal", "int literal needed, found %v", lit)
 		return
 	}
 	res, err = strconv.Atoi(lit)
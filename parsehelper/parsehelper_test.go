@@ -1,6 +1,7 @@
 package parsehelper
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/shful/gofp/mock"
@@ -81,6 +82,12 @@ func TestParseIRIWithFragment(t *testing.T) {
 	if fragment != "VegetarianPizzaEquivalent2" {
 		t.Fatal("Prefix=" + prefix + " Fragment=" + fragment)
 	}
+
+	p = mock.NewTestParser(`<http://example.com/%zz#Pizza>`)
+	_, _, err = ParseIRIWithFragment(p)
+	if !errors.Is(err, parser.ErrInvalidIRI) {
+		t.Fatal(err)
+	}
 }
 
 func TestParseUnprefixedIRI(t *testing.T) {
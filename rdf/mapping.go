@@ -0,0 +1,593 @@
+package rdf
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/dataranges"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/properties"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Triples maps the ontology o to RDF triples.
+// Mapped are the ontology header with imports and annotations, all declarations (including the implicit ones)
+// and all axioms from o.K. Axiom annotations are mapped by reification with owl:Axiom.
+// The order is the same as with the OWL-Functional writer: declarations sorted by IRI, axioms grouped by type.
+//
+// Anonymous individuals are identified by their node ID together with their document, so that equal node IDs
+// from different documents, e.g. from imports, become different blank nodes.
+// o.K must be set, i.e. o must be parsed into the default structures of the storedefaults package.
+func Triples(o *owlfunctional.Ontology) (triples []Triple, err error) {
+	if o.K == nil {
+		return nil, fmt.Errorf("cannot map ontology %v without K", o.IRI)
+	}
+	g := &graph{k: o.K, anonymous: map[individual.Individual]Term{}}
+	g.mapOntology(o)
+	return g.triples, g.err
+}
+
+// graph collects the triples. The first error is kept in err, after which mapping continues silently.
+type graph struct {
+	k       storedefaults.K
+	triples []Triple
+	err     error
+
+	blanks    int                            // count of blank nodes for expressions and axioms
+	anonymous map[individual.Individual]Term // blank nodes of anonymous individuals
+}
+
+func (g *graph) add(s, p, o Term) {
+	g.triples = append(g.triples, Triple{S: s, P: p, O: o})
+}
+
+// blank returns a new blank node.
+func (g *graph) blank() Term {
+	g.blanks++
+	return NewBlank("b" + strconv.Itoa(g.blanks))
+}
+
+// unknown records an error for a type which cannot be mapped, e.g. from a custom store implementation.
+func (g *graph) unknown(what string, x interface{}) Term {
+	if g.err == nil {
+		g.err = fmt.Errorf("cannot map %v of type %T", what, x)
+	}
+	return NewIRI("")
+}
+
+func owl(name string) Term  { return NewIRI(builtindatatypes.PRE_OWL + name) }
+func rdf(name string) Term  { return NewIRI(builtindatatypes.PRE_RDF + name) }
+func rdfs(name string) Term { return NewIRI(builtindatatypes.PRE_RDFS + name) }
+
+var rdfType = rdf("type")
+
+func (g *graph) mapOntology(o *owlfunctional.Ontology) {
+	var ont Term
+	if o.IRI != "" {
+		ont = NewIRI(strings.TrimSuffix(strings.TrimPrefix(o.IRI, "<"), ">"))
+	} else {
+		ont = g.blank()
+	}
+	g.add(ont, rdfType, owl("Ontology"))
+	if o.VERSIONIRI != "" {
+		g.add(ont, owl("versionIRI"), NewIRI(strings.TrimSuffix(strings.TrimPrefix(o.VERSIONIRI, "<"), ">")))
+	}
+	for _, iri := range o.Imports {
+		g.add(ont, owl("imports"), NewIRI(iri))
+	}
+	for _, a := range o.Annotations() {
+		g.annotate(ont, &a)
+	}
+
+	g.mapDecls()
+	g.mapAxioms()
+}
+
+func (g *graph) mapDecls() {
+	var iris []string
+	declTriples := func(class Term) {
+		sort.Strings(iris)
+		for _, iri := range iris {
+			g.add(NewIRI(iri), rdfType, class)
+		}
+		iris = iris[:0]
+	}
+
+	for _, d := range g.k.AllClassDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declTriples(owl("Class"))
+	for _, d := range g.k.AllObjectPropertyDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declTriples(owl("ObjectProperty"))
+	for _, d := range g.k.AllDataPropertyDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declTriples(owl("DatatypeProperty"))
+	for _, d := range g.k.AllAnnotationPropertyDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declTriples(owl("AnnotationProperty"))
+	for _, d := range g.k.AllDatatypeDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declTriples(rdfs("Datatype"))
+	for _, d := range g.k.AllNamedIndividualDecls() {
+		iris = append(iris, d.IRI)
+	}
+	declTriples(owl("NamedIndividual"))
+}
+
+func (g *graph) mapAxioms() {
+	k := g.k
+
+	for i, x := range k.AllDatatypeDefinitions() {
+		g.axiom(storedefaults.KindDatatypeDefinition, i, g.D(x.DN), owl("equivalentClass"), g.D(x.D))
+	}
+
+	// Class expression axioms
+	for i, x := range k.AllSubClassOfs() {
+		g.axiom(storedefaults.KindSubClassOf, i, g.C(x.C1), rdfs("subClassOf"), g.C(x.C2))
+	}
+	for i, x := range k.AllEquivalentClasses() {
+		g.pairwise(storedefaults.KindEquivalentClasses, i, g.Cs(x.EquivalentClasses), owl("equivalentClass"))
+	}
+	for i, x := range k.AllDisjointClasses() {
+		g.disjoint(storedefaults.KindDisjointClasses, i, g.Cs(x.DisjointClasses), owl("disjointWith"), owl("AllDisjointClasses"))
+	}
+	for i, x := range k.AllDisjointUnions() {
+		g.axiom(storedefaults.KindDisjointUnion, i, g.C(x.CN), owl("disjointUnionOf"), g.list(g.Cs(x.DisjointClasses)))
+	}
+
+	// Object property axioms
+	for i, x := range k.AllSubObjectPropertyOfs() {
+		g.axiom(storedefaults.KindSubObjectPropertyOf, i, g.P(x.P1), rdfs("subPropertyOf"), g.P(x.P2))
+	}
+	for i, x := range k.AllSubObjectPropertyChainOfs() {
+		g.axiom(storedefaults.KindSubObjectPropertyChainOf, i, g.P(x.P), owl("propertyChainAxiom"), g.list(g.Ps(x.Chain)))
+	}
+	for i, x := range k.AllEquivalentObjectProperties() {
+		g.pairwise(storedefaults.KindEquivalentObjectProperties, i, g.Ps(x.Ps), owl("equivalentProperty"))
+	}
+	for i, x := range k.AllDisjointObjectProperties() {
+		g.disjoint(storedefaults.KindDisjointObjectProperties, i, g.Ps(x.Ps), owl("propertyDisjointWith"), owl("AllDisjointProperties"))
+	}
+	for i, x := range k.AllInverseObjectProperties() {
+		g.axiom(storedefaults.KindInverseObjectProperties, i, g.P(x.P1), owl("inverseOf"), g.P(x.P2))
+	}
+	for i, x := range k.AllObjectPropertyDomains() {
+		g.axiom(storedefaults.KindObjectPropertyDomain, i, g.P(x.P), rdfs("domain"), g.C(x.C))
+	}
+	for i, x := range k.AllObjectPropertyRanges() {
+		g.axiom(storedefaults.KindObjectPropertyRange, i, g.P(x.P), rdfs("range"), g.C(x.C))
+	}
+	g.characteristics(storedefaults.KindFunctionalObjectProperty, k.AllFunctionalObjectProperties(), owl("FunctionalProperty"))
+	g.characteristics(storedefaults.KindInverseFunctionalObjectProperty, k.AllInverseFunctionalObjectProperties(), owl("InverseFunctionalProperty"))
+	g.characteristics(storedefaults.KindReflexiveObjectProperty, k.AllReflexiveObjectProperties(), owl("ReflexiveProperty"))
+	g.characteristics(storedefaults.KindIrreflexiveObjectProperty, k.AllIrreflexiveObjectProperties(), owl("IrreflexiveProperty"))
+	g.characteristics(storedefaults.KindSymmetricObjectProperty, k.AllSymmetricObjectProperties(), owl("SymmetricProperty"))
+	g.characteristics(storedefaults.KindAsymmetricObjectProperty, k.AllAsymmetricObjectProperties(), owl("AsymmetricProperty"))
+	g.characteristics(storedefaults.KindTransitiveObjectProperty, k.AllTransitiveObjectProperties(), owl("TransitiveProperty"))
+
+	// Data property axioms
+	for i, x := range k.AllSubDataPropertyOfs() {
+		g.axiom(storedefaults.KindSubDataPropertyOf, i, g.R(x.P1), rdfs("subPropertyOf"), g.R(x.P2))
+	}
+	for i, x := range k.AllEquivalentDataProperties() {
+		g.pairwise(storedefaults.KindEquivalentDataProperties, i, g.Rs(x.Rs), owl("equivalentProperty"))
+	}
+	for i, x := range k.AllDisjointDataProperties() {
+		g.disjoint(storedefaults.KindDisjointDataProperties, i, g.Rs(x.Rs), owl("propertyDisjointWith"), owl("AllDisjointProperties"))
+	}
+	for i, x := range k.AllDataPropertyDomains() {
+		g.axiom(storedefaults.KindDataPropertyDomain, i, g.R(x.R), rdfs("domain"), g.C(x.C))
+	}
+	for i, x := range k.AllDataPropertyRanges() {
+		g.axiom(storedefaults.KindDataPropertyRange, i, g.R(x.R), rdfs("range"), g.D(x.D))
+	}
+	for i, R := range k.AllFunctionalDataProperties() {
+		g.axiom(storedefaults.KindFunctionalDataProperty, i, g.R(R), rdfType, owl("FunctionalProperty"))
+	}
+
+	// Keys
+	for i, x := range k.AllHasKeys() {
+		g.axiom(storedefaults.KindHasKey, i, g.C(x.C), owl("hasKey"), g.list(append(g.Ps(x.Ps), g.Rs(x.Rs)...)))
+	}
+
+	// Assertions
+	for i, x := range k.AllSameIndividuals() {
+		g.pairwise(storedefaults.KindSameIndividual, i, g.As(x.As), owl("sameAs"))
+	}
+	for i, x := range k.AllDifferentIndividuals() {
+		g.disjoint(storedefaults.KindDifferentIndividuals, i, g.As(x.As), owl("differentFrom"), owl("AllDifferent"))
+	}
+	for i, x := range k.AllClassAssertions() {
+		g.axiom(storedefaults.KindClassAssertion, i, g.A(x.A), rdfType, g.C(x.C))
+	}
+	for i, x := range k.AllObjectPropertyAssertions() {
+		// ObjectInverseOf(P) a1 a2 is the same as P a2 a1
+		if inv, ok := x.P.(*properties.ObjectInverseOf); ok {
+			g.axiom(storedefaults.KindObjectPropertyAssertion, i, g.A(x.A2), NewIRI(inv.PN), g.A(x.A1))
+		} else {
+			g.axiom(storedefaults.KindObjectPropertyAssertion, i, g.A(x.A1), g.P(x.P), g.A(x.A2))
+		}
+	}
+	for i, x := range k.AllNegativeObjectPropertyAssertions() {
+		g.negativeAssertion(storedefaults.KindNegativeObjectPropertyAssertion, i, g.A(x.A1), g.P(x.P), owl("targetIndividual"), g.A(x.A2))
+	}
+	for i, x := range k.AllDataPropertyAssertions() {
+		g.axiom(storedefaults.KindDataPropertyAssertion, i, g.A(x.A), g.R(x.R), g.literal(x.V))
+	}
+	for i, x := range k.AllNegativeDataPropertyAssertions() {
+		g.negativeAssertion(storedefaults.KindNegativeDataPropertyAssertion, i, g.A(x.A), g.R(x.R), owl("targetValue"), g.literal(x.V))
+	}
+
+	// Annotation axioms
+	for i, x := range k.AllAnnotationAssertions() {
+		g.axiom(storedefaults.KindAnnotationAssertion, i, g.st(x.S, x.DocumentID), g.annotationProperty(x.A), g.st(x.T, x.DocumentID))
+	}
+	for i, x := range k.AllSubAnnotationPropertyOfs() {
+		g.axiom(storedefaults.KindSubAnnotationPropertyOf, i, g.annotationProperty(x.A1), rdfs("subPropertyOf"), g.annotationProperty(x.A2))
+	}
+	for i, x := range k.AllAnnotationPropertyDomains() {
+		g.axiom(storedefaults.KindAnnotationPropertyDomain, i, g.annotationProperty(x.A), rdfs("domain"), NewIRI(x.U))
+	}
+	for i, x := range k.AllAnnotationPropertyRanges() {
+		g.axiom(storedefaults.KindAnnotationPropertyRange, i, g.annotationProperty(x.A), rdfs("range"), NewIRI(x.U))
+	}
+}
+
+// axiom adds the main triple of the i-th axiom of the given kind, and reifies it if the axiom has annotations.
+func (g *graph) axiom(kind storedefaults.AxiomKind, i int, s, p, o Term) {
+	g.add(s, p, o)
+	anns := g.axiomAnnotations(kind, i)
+	if len(anns) == 0 {
+		return
+	}
+	x := g.blank()
+	g.add(x, rdfType, owl("Axiom"))
+	g.add(x, owl("annotatedSource"), s)
+	g.add(x, owl("annotatedProperty"), p)
+	g.add(x, owl("annotatedTarget"), o)
+	for _, a := range anns {
+		g.annotate(x, a)
+	}
+}
+
+// axiomNode adds the annotations of an axiom which is mapped to a blank node x, like owl:AllDisjointClasses.
+// Such axioms are annotated directly, without reification.
+func (g *graph) axiomNode(kind storedefaults.AxiomKind, i int, x Term) {
+	for _, a := range g.axiomAnnotations(kind, i) {
+		g.annotate(x, a)
+	}
+}
+
+// axiomAnnotations returns the annotations of the i-th axiom of the given kind, or nil if g.k does not keep annotations.
+func (g *graph) axiomAnnotations(kind storedefaults.AxiomKind, i int) []meta.Annotation {
+	if a, ok := g.k.(storedefaults.AnnotatedAxioms); ok {
+		return a.AxiomAnnotations(kind, i)
+	}
+	return nil
+}
+
+// pairwise maps an n-ary axiom like EquivalentClasses to a triple for each pair of neighbours.
+func (g *graph) pairwise(kind storedefaults.AxiomKind, i int, terms []Term, p Term) {
+	for j := 1; j < len(terms); j++ {
+		g.axiom(kind, i, terms[j-1], p, terms[j])
+	}
+}
+
+// disjoint maps an n-ary axiom like DisjointClasses to a single triple with p for two operands,
+// and otherwise to a blank node of type class with owl:members.
+func (g *graph) disjoint(kind storedefaults.AxiomKind, i int, terms []Term, p, class Term) {
+	if len(terms) == 2 {
+		g.axiom(kind, i, terms[0], p, terms[1])
+		return
+	}
+	members := g.list(terms)
+	x := g.blank()
+	g.add(x, rdfType, class)
+	g.add(x, owl("members"), members)
+	g.axiomNode(kind, i, x)
+}
+
+func (g *graph) characteristics(kind storedefaults.AxiomKind, Ps []meta.ObjectPropertyExpression, class Term) {
+	for i, P := range Ps {
+		g.axiom(kind, i, g.P(P), rdfType, class)
+	}
+}
+
+func (g *graph) negativeAssertion(kind storedefaults.AxiomKind, i int, a, p, targetPredicate, target Term) {
+	x := g.blank()
+	g.add(x, rdfType, owl("NegativePropertyAssertion"))
+	g.add(x, owl("sourceIndividual"), a)
+	g.add(x, owl("assertionProperty"), p)
+	g.add(x, targetPredicate, target)
+	g.axiomNode(kind, i, x)
+}
+
+// list maps terms to an rdf:List, and returns its head.
+func (g *graph) list(terms []Term) Term {
+	if len(terms) == 0 {
+		return rdf("nil")
+	}
+	head := g.blank()
+	node := head
+	for j, t := range terms {
+		g.add(node, rdf("first"), t)
+		if j == len(terms)-1 {
+			g.add(node, rdf("rest"), rdf("nil"))
+			break
+		}
+		next := g.blank()
+		g.add(node, rdf("rest"), next)
+		node = next
+	}
+	return head
+}
+
+func (g *graph) annotate(s Term, a meta.Annotation) {
+	g.add(s, g.annotationProperty(a.A()), g.st(a.T(), a.DocumentID()))
+}
+
+func (g *graph) annotationProperty(A meta.AnnotationProperty) Term {
+	switch A := A.(type) {
+	case *decl.AnnotationPropertyDecl:
+		return NewIRI(A.IRI)
+	case string:
+		// SubAnnotationPropertyOf keeps the IRIs only
+		return NewIRI(A)
+	}
+	return g.unknown("annotation property", A)
+}
+
+// st maps the value of an annotation subject or target.
+// The parser keeps these as strings, which are either an IRI, a node ID (_:...) or a literal (see literal.OWLLiteral.LiteralString).
+// documentID is the document of a node ID.
+func (g *graph) st(value string, documentID int64) Term {
+	if l, ok := literal.ParseLiteralString(value); ok {
+		return g.literal(l)
+	}
+	if strings.HasPrefix(value, "_:") {
+		return g.A(individual.NewAnonymous(value, documentID))
+	}
+	return NewIRI(value)
+}
+
+func (g *graph) literal(l literal.OWLLiteral) Term {
+	return NewLiteral(unescapeOWL(l.Value), l.Literaltype, l.LangTag)
+}
+
+// A maps a named individual to its IRI. An anonymous individual becomes a blank node, which is labelled when the
+// individual is first seen.
+func (g *graph) A(a individual.Individual) Term {
+	if !a.IsAnonymous() {
+		return NewIRI(a.Name)
+	}
+	if t, ok := g.anonymous[a]; ok {
+		return t
+	}
+	t := NewBlank("a" + strconv.Itoa(len(g.anonymous)+1))
+	g.anonymous[a] = t
+	return t
+}
+
+func (g *graph) As(as []individual.Individual) []Term {
+	terms := make([]Term, len(as))
+	for i, a := range as {
+		terms[i] = g.A(a)
+	}
+	return terms
+}
+
+// restriction returns a new blank node of type owl:Restriction on the property p,
+// with the further predicates and objects given in pairs.
+func (g *graph) restriction(p Term, pairs ...Term) Term {
+	x := g.blank()
+	g.add(x, rdfType, owl("Restriction"))
+	g.add(x, owl("onProperty"), p)
+	for j := 0; j+1 < len(pairs); j += 2 {
+		g.add(x, pairs[j], pairs[j+1])
+	}
+	return x
+}
+
+// cardinality returns the literal for the cardinality n.
+func cardinality(n int) Term {
+	return NewLiteral(strconv.Itoa(n), builtindatatypes.PRE_XSD+"nonNegativeInteger", "")
+}
+
+// C maps a class expression. Named classes are IRIs, all other class expressions are blank nodes.
+func (g *graph) C(C meta.ClassExpression) (x Term) {
+	switch C := C.(type) {
+	case *decl.ClassDecl:
+		return NewIRI(C.IRI)
+	case *classexpression.OWLThing:
+		return owl("Thing")
+	case *classexpression.OWLNothing:
+		return owl("Nothing")
+	case *classexpression.ObjectIntersectionOf:
+		return g.classOperation("intersectionOf", g.Cs(C.Cs))
+	case *classexpression.ObjectUnionOf:
+		return g.classOperation("unionOf", g.Cs(C.Cs))
+	case *classexpression.ObjectComplementOf:
+		complement := g.C(C.C)
+		x = g.blank()
+		g.add(x, rdfType, owl("Class"))
+		g.add(x, owl("complementOf"), complement)
+	case *classexpression.ObjectOneOf:
+		return g.classOperation("oneOf", g.As(C.As))
+	case *classexpression.ObjectSomeValuesFrom:
+		x = g.restriction(g.P(C.P), owl("someValuesFrom"), g.C(C.C))
+	case *classexpression.ObjectAllValuesFrom:
+		x = g.restriction(g.P(C.P), owl("allValuesFrom"), g.C(C.C))
+	case *classexpression.ObjectHasValue:
+		x = g.restriction(g.P(C.P), owl("hasValue"), g.A(C.A))
+	case *classexpression.ObjectHasSelf:
+		x = g.restriction(g.P(C.P), owl("hasSelf"), NewLiteral("true", builtindatatypes.PRE_XSD+"boolean", ""))
+	case *classexpression.ObjectMinCardinality:
+		x = g.restriction(g.P(C.P), owl("minCardinality"), cardinality(C.N))
+	case *classexpression.ObjectMaxCardinality:
+		x = g.restriction(g.P(C.P), owl("maxCardinality"), cardinality(C.N))
+	case *classexpression.ObjectExactCardinality:
+		x = g.restriction(g.P(C.P), owl("cardinality"), cardinality(C.N))
+	case *classexpression.ObjectQualifiedMinCardinality:
+		x = g.restriction(g.P(C.P), owl("minQualifiedCardinality"), cardinality(C.N), owl("onClass"), g.C(C.C))
+	case *classexpression.ObjectQualifiedMaxCardinality:
+		x = g.restriction(g.P(C.P), owl("maxQualifiedCardinality"), cardinality(C.N), owl("onClass"), g.C(C.C))
+	case *classexpression.ObjectQualifiedExactCardinality:
+		x = g.restriction(g.P(C.P), owl("qualifiedCardinality"), cardinality(C.N), owl("onClass"), g.C(C.C))
+	case *classexpression.DataSomeValuesFrom:
+		x = g.restriction(g.R(C.R), owl("someValuesFrom"), g.D(C.D))
+	case *classexpression.DataAllValuesFrom:
+		x = g.restriction(g.R(C.R), owl("allValuesFrom"), g.D(C.D))
+	case *classexpression.DataHasValue:
+		x = g.restriction(g.R(C.R), owl("hasValue"), g.literal(C.V))
+	case *classexpression.DataMinCardinality:
+		x = g.restriction(g.R(C.R), owl("minCardinality"), cardinality(C.N))
+	case *classexpression.DataMaxCardinality:
+		x = g.restriction(g.R(C.R), owl("maxCardinality"), cardinality(C.N))
+	case *classexpression.DataExactCardinality:
+		x = g.restriction(g.R(C.R), owl("cardinality"), cardinality(C.N))
+	case *classexpression.DataQualifiedMinCardinality:
+		x = g.restriction(g.R(C.R), owl("minQualifiedCardinality"), cardinality(C.N), owl("onDataRange"), g.D(C.D))
+	case *classexpression.DataQualifiedMaxCardinality:
+		x = g.restriction(g.R(C.R), owl("maxQualifiedCardinality"), cardinality(C.N), owl("onDataRange"), g.D(C.D))
+	case *classexpression.DataQualifiedExactCardinality:
+		x = g.restriction(g.R(C.R), owl("qualifiedCardinality"), cardinality(C.N), owl("onDataRange"), g.D(C.D))
+	default:
+		return g.unknown("class expression", C)
+	}
+	return
+}
+
+// classOperation returns a new blank node of type owl:Class, with the list of operands under owl:<name>.
+func (g *graph) classOperation(name string, operands []Term) Term {
+	list := g.list(operands)
+	x := g.blank()
+	g.add(x, rdfType, owl("Class"))
+	g.add(x, owl(name), list)
+	return x
+}
+
+func (g *graph) Cs(Cs []meta.ClassExpression) []Term {
+	terms := make([]Term, len(Cs))
+	for i, C := range Cs {
+		terms[i] = g.C(C)
+	}
+	return terms
+}
+
+// P maps an object property expression. ObjectInverseOf is a blank node with owl:inverseOf.
+func (g *graph) P(P meta.ObjectPropertyExpression) Term {
+	switch P := P.(type) {
+	case *decl.ObjectPropertyDecl:
+		return NewIRI(P.IRI)
+	case *properties.ObjectInverseOf:
+		x := g.blank()
+		g.add(x, owl("inverseOf"), NewIRI(P.PN))
+		return x
+	case *properties.OWLTopObjectProperty:
+		return owl("topObjectProperty")
+	case *properties.OWLBottomObjectProperty:
+		return owl("bottomObjectProperty")
+	}
+	return g.unknown("object property expression", P)
+}
+
+func (g *graph) Ps(Ps []meta.ObjectPropertyExpression) []Term {
+	terms := make([]Term, len(Ps))
+	for i, P := range Ps {
+		terms[i] = g.P(P)
+	}
+	return terms
+}
+
+func (g *graph) R(R meta.DataProperty) Term {
+	switch R := R.(type) {
+	case *decl.DataPropertyDecl:
+		return NewIRI(R.IRI)
+	case *properties.OWLTopDataProperty:
+		return owl("topDataProperty")
+	case *properties.OWLBottomDataProperty:
+		return owl("bottomDataProperty")
+	}
+	return g.unknown("data property", R)
+}
+
+func (g *graph) Rs(Rs []meta.DataProperty) []Term {
+	terms := make([]Term, len(Rs))
+	for i, R := range Rs {
+		terms[i] = g.R(R)
+	}
+	return terms
+}
+
+// D maps a data range. Named datatypes are IRIs, all other data ranges are blank nodes of type rdfs:Datatype.
+func (g *graph) D(D meta.DataRange) Term {
+	var x Term
+	switch D := D.(type) {
+	case *facets.BuiltinDatatype:
+		return NewIRI(D.DatatypeIRI)
+	case *facets.CustomNamedDatatype:
+		return NewIRI(D.DatatypeIRI)
+	case *decl.DatatypeDecl:
+		return NewIRI(D.IRI)
+	case *facets.DatatypeRestriction:
+		restrictions := make([]Term, len(D.FVPairs))
+		for i, fv := range D.FVPairs {
+			restrictions[i] = g.blank()
+			g.add(restrictions[i], NewIRI(fv.F.IRI()), g.literal(fv.V))
+		}
+		list := g.list(restrictions)
+		x = g.blank()
+		g.add(x, rdfType, rdfs("Datatype"))
+		g.add(x, owl("onDatatype"), g.D(D.DN))
+		g.add(x, owl("withRestrictions"), list)
+	case *dataranges.DataComplementOf:
+		complement := g.D(D.D)
+		x = g.blank()
+		g.add(x, rdfType, rdfs("Datatype"))
+		g.add(x, owl("datatypeComplementOf"), complement)
+	case *dataranges.DataIntersectionOf:
+		x = g.dataOperation("intersectionOf", g.Ds(D.Ds))
+	case *dataranges.DataUnionOf:
+		x = g.dataOperation("unionOf", g.Ds(D.Ds))
+	case *dataranges.DataOneOf:
+		values := make([]Term, len(D.Vs))
+		for i, v := range D.Vs {
+			values[i] = g.literal(v)
+		}
+		x = g.dataOperation("oneOf", values)
+	default:
+		return g.unknown("data range", D)
+	}
+	return x
+}
+
+// dataOperation returns a new blank node of type rdfs:Datatype, with the list of operands under owl:<name>.
+func (g *graph) dataOperation(name string, operands []Term) Term {
+	list := g.list(operands)
+	x := g.blank()
+	g.add(x, rdfType, rdfs("Datatype"))
+	g.add(x, owl(name), list)
+	return x
+}
+
+func (g *graph) Ds(Ds []meta.DataRange) []Term {
+	terms := make([]Term, len(Ds))
+	for i, D := range Ds {
+		terms[i] = g.D(D)
+	}
+	return terms
+}
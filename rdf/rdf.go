@@ -0,0 +1,119 @@
+// rdf maps a parsed ontology to RDF triples, following the W3C "OWL 2 Web Ontology Language Mapping to RDF Graphs"
+// (https://www.w3.org/TR/owl2-mapping-to-rdf/), and writes these as N-Triples or Turtle.
+//
+// Blank nodes are labelled in the order they are created, so that the same ontology always gives the same output.
+// Node IDs of anonymous individuals are relabelled, too.
+package rdf
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+)
+
+type TermKind int
+
+const (
+	IRI TermKind = iota
+	Blank
+	Literal
+)
+
+// Term is a node in the RDF graph: an IRI, a blank node or a literal.
+type Term struct {
+	Kind TermKind
+
+	// Value is the full IRI, the blank node label without "_:", or the lexical form of the literal.
+	// The lexical form is unescaped, e.g. a literal "a \"b\"" in OWL-Functional has the Value a "b".
+	Value string
+
+	// Datatype is the full datatype IRI of a literal. It is empty for a literal with language tag.
+	Datatype string
+
+	// Lang is the language tag of a literal, or empty.
+	Lang string
+}
+
+// Triple is a single RDF statement.
+type Triple struct {
+	S, P, O Term
+}
+
+func NewIRI(iri string) Term {
+	return Term{Kind: IRI, Value: iri}
+}
+
+func NewBlank(label string) Term {
+	return Term{Kind: Blank, Value: label}
+}
+
+// NewLiteral returns a literal term. With a language tag, the datatype is ignored.
+func NewLiteral(value, datatype, lang string) Term {
+	if lang != "" {
+		datatype = ""
+	}
+	return Term{Kind: Literal, Value: value, Datatype: datatype, Lang: lang}
+}
+
+// NTriples writes the term as in N-Triples, with full IRIs.
+// A literal of type xsd:string is written without datatype, which means the same in RDF 1.1.
+func (t Term) NTriples() string {
+	switch t.Kind {
+	case IRI:
+		return "<" + escapeIRI(t.Value) + ">"
+	case Blank:
+		return "_:" + t.Value
+	}
+	res := `"` + escapeString(t.Value) + `"`
+	if t.Lang != "" {
+		return res + "@" + t.Lang
+	}
+	if t.Datatype != "" && t.Datatype != xsdString {
+		res += "^^<" + escapeIRI(t.Datatype) + ">"
+	}
+	return res
+}
+
+func (t Term) String() string {
+	return t.NTriples()
+}
+
+func (t Triple) String() string {
+	return fmt.Sprintf("%v %v %v .", t.S.NTriples(), t.P.NTriples(), t.O.NTriples())
+}
+
+var xsdString = builtindatatypes.PRE_XSD + "string"
+
+// escapeString escapes a literal value for N-Triples and Turtle.
+func escapeString(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`).Replace(value)
+}
+
+// escapeIRI escapes the characters which are not allowed in an IRIREF of N-Triples and Turtle.
+func escapeIRI(iri string) string {
+	var sb strings.Builder
+	for _, ch := range iri {
+		if ch <= 0x20 || strings.ContainsRune("<>\"{}|^`\\", ch) {
+			fmt.Fprintf(&sb, `\u%04X`, ch)
+			continue
+		}
+		sb.WriteRune(ch)
+	}
+	return sb.String()
+}
+
+// unescapeOWL returns the literal value of OWL-Functional, where \" and \\ are escaped, without escapes.
+func unescapeOWL(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+	var sb strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+		}
+		sb.WriteByte(value[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,114 @@
+package rdf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp/mock/mockontology"
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/storedefaults"
+)
+
+func TestWriteNTriples(t *testing.T) {
+	o := mockontology.Pizza(t)
+	var sb strings.Builder
+	if err := WriteNTriples(&sb, o); err != nil {
+		t.Fatal(err)
+	}
+	text := sb.String()
+	for _, expected := range []string{
+		`<http://example.com/pizza> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/2002/07/owl#Ontology> .`,
+		`<http://example.com/pizza> <http://www.w3.org/2000/01/rdf-schema#comment> "Pizzas"@en .`,
+		`<http://example.com/pizza#Pizza> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/2002/07/owl#Class> .`,
+		`<http://example.com/pizza#Margherita> <http://www.w3.org/2000/01/rdf-schema#subClassOf> _:b7 .`,
+		`_:b7 <http://www.w3.org/2002/07/owl#intersectionOf> _:b5 .`,
+		`_:b4 <http://www.w3.org/2002/07/owl#someValuesFrom> <http://example.com/pizza#Tomato> .`,
+		`_:b8 <http://www.w3.org/2002/07/owl#minQualifiedCardinality> "1"^^<http://www.w3.org/2001/XMLSchema#nonNegativeInteger> .`,
+		`_:b56 <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://www.w3.org/2002/07/owl#AllDisjointClasses> .`,
+		`_:b56 <http://www.w3.org/2002/07/owl#members> _:b53 .`,
+		`<http://example.com/pizza#hasTopping> <http://www.w3.org/2002/07/owl#equivalentProperty> <http://example.com/pizza#hasCover> .`,
+		`_:b61 <http://www.w3.org/2002/07/owl#annotatedSource> <http://example.com/pizza#hasBase> .`,
+		`_:b61 <http://www.w3.org/2000/01/rdf-schema#comment> "one base only" .`,
+		`<http://example.com/pizza#Diavolo> <http://example.com/pizza#hasTopping> _:a1 .`,
+		`<http://example.com/pizza#Diavolo> <http://example.com/pizza#isToppingOf> _:a1 .`,
+		`_:b69 <http://www.w3.org/2002/07/owl#targetValue> "Angel" .`,
+		`<http://example.com/pizza#Diavolo> <http://example.com/pizza#hasName> "Teufel"@de .`,
+		`<http://example.com/pizza#Pizza> <http://www.w3.org/2000/01/rdf-schema#comment> "a \"round\" dish" .`,
+	} {
+		if !strings.Contains(text, expected+"\n") {
+			t.Fatalf("missing %v in\n%v", expected, text)
+		}
+	}
+
+	// the labels of blank nodes do not change between runs
+	var sb2 strings.Builder
+	if err := WriteNTriples(&sb2, mockontology.Pizza(t)); err != nil {
+		t.Fatal(err)
+	}
+	if sb2.String() != text {
+		t.Fatalf("output differs:\n%v\n---\n%v", text, sb2.String())
+	}
+}
+
+func TestWriteTurtle(t *testing.T) {
+	o := mockontology.Pizza(t)
+	var sb strings.Builder
+	if err := WriteTurtle(&sb, o); err != nil {
+		t.Fatal(err)
+	}
+	text := sb.String()
+	for _, expected := range []string{
+		"@prefix : <http://example.com/pizza#> .\n",
+		"@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .\n",
+		"\n<http://example.com/pizza> a owl:Ontology ;\n    owl:versionIRI <http://example.com/pizza/1.0> ;\n" +
+			"    owl:imports <http://example.com/food> ;\n    rdfs:comment \"Pizzas\"@en .\n",
+		"\n_:b8 a owl:Restriction ;\n    owl:onProperty :hasBase ;\n" +
+			"    owl:minQualifiedCardinality \"1\"^^xsd:nonNegativeInteger ;\n    owl:onClass :PizzaBase .\n",
+		"\n:Pizza rdfs:comment \"a \\\"round\\\" dish\" ;\n    rdfs:seeAlso <http://en.wikipedia.org/wiki/Pizza> .\n",
+	} {
+		if !strings.Contains(text, expected) {
+			t.Fatalf("missing %q in\n%v", expected, text)
+		}
+	}
+}
+
+func TestTriplesWithoutK(t *testing.T) {
+	if _, err := Triples(&owlfunctional.Ontology{}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestAnonymousIndividualsOfDocuments(t *testing.T) {
+	k := storedefaults.NewDefaultK()
+	k.ExplicitDecls = false
+	P, _ := k.ObjectPropertyDecl("http://example.com/pizza#hasTopping")
+	A, _ := k.AnnotationPropertyDecl("http://www.w3.org/2000/01/rdf-schema#seeAlso")
+	diavolo, _ := k.NamedIndividualDecl("http://example.com/pizza#Diavolo")
+
+	// the same node ID in two documents, e.g. an ontology and its import
+	x1 := individual.NewAnonymous("_:x1", 1)
+	x2 := individual.NewAnonymous("_:x1", 2)
+	k.StoreObjectPropertyAssertion(P, individual.NewNamed(diavolo), x1, nil)
+	k.StoreObjectPropertyAssertion(P, individual.NewNamed(diavolo), x2, nil)
+	k.StoreAnnotationAssertion(A, x2.NodeID, diavolo.IRI, x2.DocumentID, nil)
+
+	triples, err := Triples(&owlfunctional.Ontology{K: k})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var toppings []Term
+	var seeAlso Triple
+	for _, x := range triples {
+		switch x.P {
+		case NewIRI(P.(*decl.ObjectPropertyDecl).IRI):
+			toppings = append(toppings, x.O)
+		case NewIRI(A.(*decl.AnnotationPropertyDecl).IRI):
+			seeAlso = x
+		}
+	}
+	if len(toppings) != 2 || toppings[0] == toppings[1] || seeAlso.S != toppings[1] {
+		t.Fatal(toppings, seeAlso)
+	}
+}
@@ -0,0 +1,174 @@
+package rdf
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/builtindatatypes"
+)
+
+// WriteNTriples writes the ontology o as N-Triples to w, one triple per line, in the order of Triples.
+func WriteNTriples(w io.Writer, o *owlfunctional.Ontology) (err error) {
+	triples, err := Triples(o)
+	if err != nil {
+		return
+	}
+	bw := bufio.NewWriter(w)
+	for _, t := range triples {
+		if _, err = fmt.Fprintln(bw, t); err != nil {
+			return
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteTurtle writes the ontology o as Turtle to w.
+// The prefixes are those of o, plus rdf, rdfs, owl and xsd unless o uses these names already.
+// Consecutive triples with the same subject are joined with ";". IRIs are shortened with the prefixes
+// wherever the result is a valid prefixed name. Blank nodes keep the labels of Triples.
+func WriteTurtle(w io.Writer, o *owlfunctional.Ontology) (err error) {
+	triples, err := Triples(o)
+	if err != nil {
+		return
+	}
+	bw := bufio.NewWriter(w)
+	t := newTw(bw, o.Prefixes)
+	t.writeTriples(triples)
+	if t.err != nil {
+		return t.err
+	}
+	return bw.Flush()
+}
+
+// tw writes Turtle. The first error is kept in err, after which writing continues silently.
+type tw struct {
+	w           io.Writer
+	prefixes    map[string]string
+	prefixNames []string // sorted keys of prefixes
+	err         error
+}
+
+func newTw(w io.Writer, ontologyPrefixes map[string]string) *tw {
+	t := &tw{w: w, prefixes: map[string]string{}}
+	for name, head := range ontologyPrefixes {
+		if isPrefixName(name) {
+			t.prefixes[name] = head
+		}
+	}
+	for name, head := range map[string]string{
+		"rdf":  builtindatatypes.PRE_RDF,
+		"rdfs": builtindatatypes.PRE_RDFS,
+		"owl":  builtindatatypes.PRE_OWL,
+		"xsd":  builtindatatypes.PRE_XSD,
+	} {
+		if _, ok := t.prefixes[name]; !ok {
+			t.prefixes[name] = head
+		}
+	}
+	for name := range t.prefixes {
+		t.prefixNames = append(t.prefixNames, name)
+	}
+	sort.Strings(t.prefixNames)
+	return t
+}
+
+func (t *tw) text(format string, args ...interface{}) {
+	if t.err != nil {
+		return
+	}
+	_, t.err = fmt.Fprintf(t.w, format, args...)
+}
+
+func (t *tw) writeTriples(triples []Triple) {
+	for _, name := range t.prefixNames {
+		t.text("@prefix %v: <%v> .\n", name, escapeIRI(t.prefixes[name]))
+	}
+	for i, tr := range triples {
+		if i > 0 && tr.S == triples[i-1].S {
+			t.text(" ;\n    %v %v", t.predicate(tr.P), t.term(tr.O))
+			continue
+		}
+		if i > 0 {
+			t.text(" .\n")
+		}
+		t.text("\n%v %v %v", t.term(tr.S), t.predicate(tr.P), t.term(tr.O))
+	}
+	if len(triples) > 0 {
+		t.text(" .\n")
+	}
+}
+
+func (t *tw) predicate(p Term) string {
+	if p.Kind == IRI && p.Value == builtindatatypes.PRE_RDF+"type" {
+		return "a"
+	}
+	return t.term(p)
+}
+
+func (t *tw) term(x Term) string {
+	switch x.Kind {
+	case IRI:
+		return t.iri(x.Value)
+	case Literal:
+		res := `"` + escapeString(x.Value) + `"`
+		if x.Lang != "" {
+			return res + "@" + x.Lang
+		}
+		if x.Datatype != "" && x.Datatype != xsdString {
+			res += "^^" + t.iri(x.Datatype)
+		}
+		return res
+	}
+	return x.NTriples()
+}
+
+// iri returns the prefixed name for iri with the longest matching prefix, or the full IRI in <>.
+func (t *tw) iri(iri string) string {
+	var best, bestHead string
+	var found bool
+	for _, name := range t.prefixNames {
+		head := t.prefixes[name]
+		if !strings.HasPrefix(iri, head) || (found && len(head) <= len(bestHead)) {
+			continue
+		}
+		if isLocalName(iri[len(head):]) {
+			best, bestHead, found = name, head, true
+		}
+	}
+	if found {
+		return best + ":" + iri[len(bestHead):]
+	}
+	return "<" + escapeIRI(iri) + ">"
+}
+
+// isPrefixName is true if name can be used as prefix in Turtle. This is stricter than the Turtle grammar.
+func isPrefixName(name string) bool {
+	for i, ch := range name {
+		if i == 0 && !unicode.IsLetter(ch) {
+			return false
+		}
+		if !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' && ch != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// isLocalName is true if name, written after "prefix:", is a valid local name in Turtle.
+// This is stricter than the Turtle grammar, e.g. names with "." or escapes are written as full IRIs.
+func isLocalName(name string) bool {
+	for i, ch := range name {
+		if i == 0 && !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' {
+			return false
+		}
+		if !unicode.IsLetter(ch) && !unicode.IsDigit(ch) && ch != '_' && ch != '-' {
+			return false
+		}
+	}
+	return true
+}
@@ -4,6 +4,7 @@ package store
 // See the storedefaults package for the reference implementation.
 
 import (
+	"github.com/shful/gofp/owlfunctional/decl"
 	"github.com/shful/gofp/owlfunctional/individual"
 	"github.com/shful/gofp/owlfunctional/literal"
 	"github.com/shful/gofp/owlfunctional/meta"
@@ -16,10 +17,23 @@ type Decls interface {
 	ClassDecl(ident string) (meta.ClassExpression, bool)
 	DataPropertyDecl(ident string) (meta.DataProperty, bool)
 	DatatypeDecl(ident string) (meta.DataRange, bool)
-	NamedIndividualDecl(ident string) (interface{}, bool)
+	NamedIndividualDecl(ident string) (*decl.NamedIndividualDecl, bool)
 	ObjectPropertyDecl(ident string) (meta.ObjectPropertyExpression, bool)
 }
 
+// DatatypeDefinitions can optionally be implemented by the Decls given to the parser.
+// If so, the parser resolves a custom datatype to the data range of its DatatypeDefinition axiom.
+type DatatypeDefinitions interface {
+	DatatypeDefinition(iri string) (meta.DataRange, bool)
+}
+
+// Failer can optionally be implemented by the AxiomStore given to the parser.
+// If so, the parser calls Err after each statement, and stops with that error if it is not nil.
+// This way, a store can stop parsing, e.g. when the underlying storage failed.
+type Failer interface {
+	Err() error
+}
+
 // DeclStore is used by the parser to store explicit declarations.
 // The store functions should return error if the declaration was already explicitly given. The "should" wording is because a custom implementation
 // of DeclStore may choose to silently ignore double declarations.
@@ -35,7 +49,8 @@ type DeclStore interface {
 
 // AxiomStore takes all possible axioms and encapsulates the data structures to store them.
 type AxiomStore interface {
-	StoreAnnotationAssertion(A meta.AnnotationProperty, S string, t string, anns []meta.Annotation)
+	// StoreAnnotationAssertion takes the document of S and t as documentID if one of them is an anonymous individual, and 0 otherwise.
+	StoreAnnotationAssertion(A meta.AnnotationProperty, S string, t string, documentID int64, anns []meta.Annotation)
 	StoreAnnotationPropertyDomain(A meta.AnnotationProperty, U string, anns []meta.Annotation)
 	StoreAnnotationPropertyRange(A meta.AnnotationProperty, U string, anns []meta.Annotation)
 	StoreAsymmetricObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation)
@@ -48,17 +63,27 @@ type AxiomStore interface {
 	StoreIrreflexiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation)
 	StoreDataPropertyDomain(R meta.DataProperty, C meta.ClassExpression, anns []meta.Annotation)
 	StoreDataPropertyRange(R meta.DataProperty, D meta.DataRange, anns []meta.Annotation)
+	StoreDatatypeDefinition(DN meta.NamedDatatype, D meta.DataRange, anns []meta.Annotation)
 	StoreDisjointClasses(Cs []meta.ClassExpression, anns []meta.Annotation)
+	StoreDisjointDataProperties(Rs []meta.DataProperty, anns []meta.Annotation)
+	StoreDisjointObjectProperties(Ps []meta.ObjectPropertyExpression, anns []meta.Annotation)
+	StoreDisjointUnion(CN meta.ClassExpression, Cs []meta.ClassExpression, anns []meta.Annotation)
 	StoreDifferentIndividuals(as []individual.Individual, anns []meta.Annotation)
 	StoreEquivalentClasses(Cs []meta.ClassExpression, anns []meta.Annotation)
-	StoreNegativeObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual)
-	StoreObjectPropertyAssertion(PN string, a1 individual.Individual, a2 individual.Individual)
+	StoreEquivalentDataProperties(Rs []meta.DataProperty, anns []meta.Annotation)
+	StoreEquivalentObjectProperties(Ps []meta.ObjectPropertyExpression, anns []meta.Annotation)
+	StoreHasKey(C meta.ClassExpression, Ps []meta.ObjectPropertyExpression, Rs []meta.DataProperty, anns []meta.Annotation)
+	StoreNegativeDataPropertyAssertion(R meta.DataProperty, a individual.Individual, v literal.OWLLiteral, anns []meta.Annotation)
+	StoreNegativeObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual, anns []meta.Annotation)
+	StoreObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual, anns []meta.Annotation)
 	StoreObjectPropertyDomain(P meta.ObjectPropertyExpression, C meta.ClassExpression, anns []meta.Annotation)
 	StoreObjectPropertyRange(P meta.ObjectPropertyExpression, C meta.ClassExpression, anns []meta.Annotation)
 	StoreReflexiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation)
+	StoreSameIndividual(as []individual.Individual, anns []meta.Annotation)
 	StoreSubAnnotationPropertyOf(A1, A2 string, anns []meta.Annotation)
 	StoreSubClassOf(Csub, Csuper meta.ClassExpression, anns []meta.Annotation)
 	StoreSubDataPropertyOf(P1, P2 meta.DataProperty, anns []meta.Annotation)
+	StoreSubObjectPropertyChainOf(Chain []meta.ObjectPropertyExpression, P meta.ObjectPropertyExpression, anns []meta.Annotation)
 	StoreSubObjectPropertyOf(P1, P2 meta.ObjectPropertyExpression, anns []meta.Annotation)
 	StoreSymmetricObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation)
 	StoreTransitiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation)
@@ -4,15 +4,23 @@ import (
 	"github.com/shful/gofp/owlfunctional/annotations"
 	"github.com/shful/gofp/owlfunctional/assertions"
 	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/facets"
 	"github.com/shful/gofp/owlfunctional/individual"
 	"github.com/shful/gofp/owlfunctional/literal"
 	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/structural"
 	"github.com/shful/gofp/store"
 )
 
 // AxiomStore holds all axioms and declarations of a single ontology, as read by the parser. It's the "raw" data. i.e. has no inferred knowledge.
+// The axioms of each kind are a set: an axiom which is structurally equal to a stored one (see package structural) is not stored again,
+// but its annotations are added to those of the stored axiom. The All* methods return the axioms in the order of their first occurrence.
 type AxiomStore struct {
 
+	// AppendOnly = true stores each axiom, including duplicates, as it comes from the parser.
+	// This is faster for large inputs which are known to be duplicate free. It must be set before storing the first axiom.
+	AppendOnly bool
+
 	// Axioms
 	allAnnotationAssertions              []annotations.AnnotationAssertion
 	allAnnotationPropertyDomains         []annotations.AnnotationPropertyDomain
@@ -22,29 +30,62 @@ type AxiomStore struct {
 	allDataPropertyAssertions            []axioms.DataPropertyAssertion
 	allDataPropertyDomains               []axioms.DataPropertyDomain
 	allDataPropertyRanges                []axioms.DataPropertyRange
+	allDatatypeDefinitions               []axioms.DatatypeDefinition
 	allDisjointClasses                   []axioms.DisjointClasses
+	allDisjointDataProperties            []axioms.DisjointDataProperties
+	allDisjointObjectProperties          []axioms.DisjointObjectProperties
+	allDisjointUnions                    []axioms.DisjointUnion
 	allDifferentIndividuals              []axioms.DifferentIndividuals
 	allEquivalentClasses                 []axioms.EquivalentClasses
+	allEquivalentDataProperties          []axioms.EquivalentDataProperties
+	allEquivalentObjectProperties        []axioms.EquivalentObjectProperties
 	allFunctionalDataProperties          []meta.DataProperty
 	allFunctionalObjectProperties        []meta.ObjectPropertyExpression
+	allHasKeys                           []axioms.HasKey
 	allInverseFunctionalObjectProperties []meta.ObjectPropertyExpression
 	allInverseObjectProperties           []axioms.InverseObjectProperties
 	allIrreflexiveObjectProperties       []meta.ObjectPropertyExpression
+	allNegativeDataPropertyAssertions    []assertions.NegativeDataPropertyAssertion
 	allNegativeObjectPropertyAssertions  []assertions.NegativeObjectPropertyAssertion
 	allObjectPropertyAssertions          []assertions.ObjectPropertyAssertion
 	allObjectPropertyDomains             []axioms.ObjectPropertyDomain
 	allObjectPropertyRanges              []axioms.ObjectPropertyRange
 	allReflexiveObjectProperties         []meta.ObjectPropertyExpression
+	allSameIndividuals                   []axioms.SameIndividual
 	allSubAnnotationPropertyOfs          []annotations.SubAnnotationPropertyOf
 	allSubClassOfs                       []axioms.SubClassOf
 	allSubDataPropertyOfs                []axioms.SubDataPropertyOf
+	allSubObjectPropertyChainOfs         []axioms.SubObjectPropertyChainOf
 	allSubObjectPropertyOfs              []axioms.SubObjectPropertyOf
 	allSymmetricObjectProperties         []meta.ObjectPropertyExpression
 	allTransitiveObjectProperties        []meta.ObjectPropertyExpression
+
+	// datatypeDefinitions maps each datatype IRI to the data range of its first DatatypeDefinition.
+	datatypeDefinitions map[string]meta.DataRange
+
+	// allAxiomAnnotations are the annotations of all annotated axioms.
+	allAxiomAnnotations map[axiomRef][]meta.Annotation
+
+	// index maps each stored axiom to its index in the slice of its kind. Not used when AppendOnly.
+	index map[axiomKey]int
+}
+
+// axiomKey identifies a stored axiom by its kind and its structural key.
+type axiomKey struct {
+	kind AxiomKind
+	key  string
+}
+
+// axiomRef identifies a stored axiom by its kind and its index in the slice of that kind.
+type axiomRef struct {
+	kind  AxiomKind
+	index int
 }
 
 var _ AllAxioms = (*AxiomStore)(nil)
+var _ AnnotatedAxioms = (*AxiomStore)(nil)
 var _ store.AxiomStore = (*AxiomStore)(nil)
+var _ store.DatatypeDefinitions = (*AxiomStore)(nil)
 
 func NewAxiomStore() *AxiomStore {
 	return &AxiomStore{}
@@ -82,6 +123,10 @@ func (s *AxiomStore) AllFunctionalObjectProperties() []meta.ObjectPropertyExpres
 	return s.allFunctionalObjectProperties
 }
 
+func (s *AxiomStore) AllHasKeys() []axioms.HasKey {
+	return s.allHasKeys
+}
+
 func (s *AxiomStore) AllInverseFunctionalObjectProperties() []meta.ObjectPropertyExpression {
 	return s.allInverseFunctionalObjectProperties
 }
@@ -102,10 +147,32 @@ func (s *AxiomStore) AllDataPropertyRanges() []axioms.DataPropertyRange {
 	return s.allDataPropertyRanges
 }
 
+func (s *AxiomStore) AllDatatypeDefinitions() []axioms.DatatypeDefinition {
+	return s.allDatatypeDefinitions
+}
+
+// DatatypeDefinition returns the data range of the first DatatypeDefinition for the datatype iri.
+func (s *AxiomStore) DatatypeDefinition(iri string) (D meta.DataRange, ok bool) {
+	D, ok = s.datatypeDefinitions[iri]
+	return
+}
+
 func (s *AxiomStore) AllDisjointClasses() []axioms.DisjointClasses {
 	return s.allDisjointClasses
 }
 
+func (s *AxiomStore) AllDisjointDataProperties() []axioms.DisjointDataProperties {
+	return s.allDisjointDataProperties
+}
+
+func (s *AxiomStore) AllDisjointObjectProperties() []axioms.DisjointObjectProperties {
+	return s.allDisjointObjectProperties
+}
+
+func (s *AxiomStore) AllDisjointUnions() []axioms.DisjointUnion {
+	return s.allDisjointUnions
+}
+
 func (s *AxiomStore) AllDifferentIndividuals() []axioms.DifferentIndividuals {
 	return s.allDifferentIndividuals
 }
@@ -114,6 +181,18 @@ func (s *AxiomStore) AllEquivalentClasses() []axioms.EquivalentClasses {
 	return s.allEquivalentClasses
 }
 
+func (s *AxiomStore) AllEquivalentDataProperties() []axioms.EquivalentDataProperties {
+	return s.allEquivalentDataProperties
+}
+
+func (s *AxiomStore) AllEquivalentObjectProperties() []axioms.EquivalentObjectProperties {
+	return s.allEquivalentObjectProperties
+}
+
+func (s *AxiomStore) AllNegativeDataPropertyAssertions() []assertions.NegativeDataPropertyAssertion {
+	return s.allNegativeDataPropertyAssertions
+}
+
 func (s *AxiomStore) AllNegativeObjectPropertyAssertions() []assertions.NegativeObjectPropertyAssertion {
 	return s.allNegativeObjectPropertyAssertions
 }
@@ -134,6 +213,14 @@ func (s *AxiomStore) AllReflexiveObjectProperties() []meta.ObjectPropertyExpress
 	return s.allReflexiveObjectProperties
 }
 
+func (s *AxiomStore) AllSameIndividuals() []axioms.SameIndividual {
+	return s.allSameIndividuals
+}
+
+func (s *AxiomStore) AllSubAnnotationPropertyOfs() []annotations.SubAnnotationPropertyOf {
+	return s.allSubAnnotationPropertyOfs
+}
+
 func (s *AxiomStore) AllSubClassOfs() []axioms.SubClassOf {
 	return s.allSubClassOfs
 }
@@ -142,6 +229,10 @@ func (s *AxiomStore) AllSubDataPropertyOfs() []axioms.SubDataPropertyOf {
 	return s.allSubDataPropertyOfs
 }
 
+func (s *AxiomStore) AllSubObjectPropertyChainOfs() []axioms.SubObjectPropertyChainOf {
+	return s.allSubObjectPropertyChainOfs
+}
+
 func (s *AxiomStore) AllSubObjectPropertyOfs() []axioms.SubObjectPropertyOf {
 	return s.allSubObjectPropertyOfs
 }
@@ -154,112 +245,397 @@ func (s *AxiomStore) AllTransitiveObjectProperties() []meta.ObjectPropertyExpres
 	return s.allTransitiveObjectProperties
 }
 
-func (s *AxiomStore) StoreAnnotationAssertion(A meta.AnnotationProperty, S string, t string, anns []meta.Annotation) {
-	s.allAnnotationAssertions = append(s.allAnnotationAssertions, annotations.AnnotationAssertion{A: A, S: S, T: t})
+// AxiomAnnotations returns the annotations of the i-th axiom of the given kind. nil if the axiom had no annotations.
+// For example, AxiomAnnotations(KindSubClassOf, 0) returns the annotations of AllSubClassOfs()[0],
+// and AxiomAnnotations(KindFunctionalObjectProperty, 2) those of AllFunctionalObjectProperties()[2].
+func (s *AxiomStore) AxiomAnnotations(kind AxiomKind, i int) []meta.Annotation {
+	return s.allAxiomAnnotations[axiomRef{kind: kind, index: i}]
+}
+
+// annotate keeps the annotations of the i-th axiom of the given kind.
+func (s *AxiomStore) annotate(kind AxiomKind, i int, anns []meta.Annotation) {
+	if len(anns) == 0 {
+		return
+	}
+	if s.allAxiomAnnotations == nil {
+		s.allAxiomAnnotations = map[axiomRef][]meta.Annotation{}
+	}
+	s.allAxiomAnnotations[axiomRef{kind: kind, index: i}] = anns
+}
+
+// duplicate reports whether an axiom x of the given kind is stored already, and then adds anns to the annotations of the stored axiom.
+// Otherwise, x is remembered as the i-th axiom of that kind, which the caller must store.
+func (s *AxiomStore) duplicate(kind AxiomKind, x interface{}, i int, anns []meta.Annotation) bool {
+	if s.AppendOnly {
+		return false
+	}
+	if s.index == nil {
+		s.index = map[axiomKey]int{}
+	}
+	k := axiomKey{kind: kind, key: structural.Key(x)}
+	j, ok := s.index[k]
+	if !ok {
+		s.index[k] = i
+		return false
+	}
+	merged := append([]meta.Annotation(nil), s.AxiomAnnotations(kind, j)...)
+	for _, ann := range anns {
+		if !containsAnnotation(merged, ann) {
+			merged = append(merged, ann)
+		}
+	}
+	s.annotate(kind, j, merged)
+	return true
+}
+
+func containsAnnotation(anns []meta.Annotation, ann meta.Annotation) bool {
+	for _, a := range anns {
+		if structural.Equal(a, ann) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *AxiomStore) StoreAnnotationAssertion(A meta.AnnotationProperty, S string, t string, documentID int64, anns []meta.Annotation) {
+	x := annotations.AnnotationAssertion{A: A, S: S, T: t, DocumentID: documentID}
+	if s.duplicate(KindAnnotationAssertion, x, len(s.allAnnotationAssertions), anns) {
+		return
+	}
+	s.allAnnotationAssertions = append(s.allAnnotationAssertions, x)
+	s.annotate(KindAnnotationAssertion, len(s.allAnnotationAssertions)-1, anns)
 }
 
 func (s *AxiomStore) StoreAnnotationPropertyDomain(A meta.AnnotationProperty, U string, anns []meta.Annotation) {
-	s.allAnnotationPropertyDomains = append(s.allAnnotationPropertyDomains, annotations.AnnotationPropertyDomain{A: A, U: U})
+	x := annotations.AnnotationPropertyDomain{A: A, U: U}
+	if s.duplicate(KindAnnotationPropertyDomain, x, len(s.allAnnotationPropertyDomains), anns) {
+		return
+	}
+	s.allAnnotationPropertyDomains = append(s.allAnnotationPropertyDomains, x)
+	s.annotate(KindAnnotationPropertyDomain, len(s.allAnnotationPropertyDomains)-1, anns)
 }
 
 func (s *AxiomStore) StoreAnnotationPropertyRange(A meta.AnnotationProperty, U string, anns []meta.Annotation) {
-	s.allAnnotationPropertyRanges = append(s.allAnnotationPropertyRanges, annotations.AnnotationPropertyRange{A: A, U: U})
+	x := annotations.AnnotationPropertyRange{A: A, U: U}
+	if s.duplicate(KindAnnotationPropertyRange, x, len(s.allAnnotationPropertyRanges), anns) {
+		return
+	}
+	s.allAnnotationPropertyRanges = append(s.allAnnotationPropertyRanges, x)
+	s.annotate(KindAnnotationPropertyRange, len(s.allAnnotationPropertyRanges)-1, anns)
 }
 
 func (s *AxiomStore) StoreAsymmetricObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allAsymmetricObjectProperties = append(s.allAsymmetricObjectProperties, P)
+	x := P
+	if s.duplicate(KindAsymmetricObjectProperty, x, len(s.allAsymmetricObjectProperties), anns) {
+		return
+	}
+	s.allAsymmetricObjectProperties = append(s.allAsymmetricObjectProperties, x)
+	s.annotate(KindAsymmetricObjectProperty, len(s.allAsymmetricObjectProperties)-1, anns)
 }
 
 func (s *AxiomStore) StoreClassAssertion(C meta.ClassExpression, a individual.Individual, anns []meta.Annotation) {
-	s.allClassAssertions = append(s.allClassAssertions, axioms.ClassAssertion{C: C, A: a})
+	x := axioms.ClassAssertion{C: C, A: a}
+	if s.duplicate(KindClassAssertion, x, len(s.allClassAssertions), anns) {
+		return
+	}
+	s.allClassAssertions = append(s.allClassAssertions, x)
+	s.annotate(KindClassAssertion, len(s.allClassAssertions)-1, anns)
 }
 
 func (s *AxiomStore) StoreDataPropertyAssertion(R meta.DataProperty, a individual.Individual, v literal.OWLLiteral, anns []meta.Annotation) {
-	s.allDataPropertyAssertions = append(s.allDataPropertyAssertions, axioms.DataPropertyAssertion{R: R, A: a, V: v})
+	x := axioms.DataPropertyAssertion{R: R, A: a, V: v}
+	if s.duplicate(KindDataPropertyAssertion, x, len(s.allDataPropertyAssertions), anns) {
+		return
+	}
+	s.allDataPropertyAssertions = append(s.allDataPropertyAssertions, x)
+	s.annotate(KindDataPropertyAssertion, len(s.allDataPropertyAssertions)-1, anns)
 }
 
 func (s *AxiomStore) StoreFunctionalDataProperty(a meta.DataProperty, anns []meta.Annotation) {
-	s.allFunctionalDataProperties = append(s.allFunctionalDataProperties, a)
+	x := a
+	if s.duplicate(KindFunctionalDataProperty, x, len(s.allFunctionalDataProperties), anns) {
+		return
+	}
+	s.allFunctionalDataProperties = append(s.allFunctionalDataProperties, x)
+	s.annotate(KindFunctionalDataProperty, len(s.allFunctionalDataProperties)-1, anns)
 }
 
 func (s *AxiomStore) StoreFunctionalObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allFunctionalObjectProperties = append(s.allFunctionalObjectProperties, P)
+	x := P
+	if s.duplicate(KindFunctionalObjectProperty, x, len(s.allFunctionalObjectProperties), anns) {
+		return
+	}
+	s.allFunctionalObjectProperties = append(s.allFunctionalObjectProperties, x)
+	s.annotate(KindFunctionalObjectProperty, len(s.allFunctionalObjectProperties)-1, anns)
+}
+
+func (s *AxiomStore) StoreHasKey(C meta.ClassExpression, Ps []meta.ObjectPropertyExpression, Rs []meta.DataProperty, anns []meta.Annotation) {
+	x := axioms.HasKey{C: C, Ps: Ps, Rs: Rs}
+	if s.duplicate(KindHasKey, x, len(s.allHasKeys), anns) {
+		return
+	}
+	s.allHasKeys = append(s.allHasKeys, x)
+	s.annotate(KindHasKey, len(s.allHasKeys)-1, anns)
 }
 
 func (s *AxiomStore) StoreInverseFunctionalObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allInverseFunctionalObjectProperties = append(s.allInverseFunctionalObjectProperties, P)
+	x := P
+	if s.duplicate(KindInverseFunctionalObjectProperty, x, len(s.allInverseFunctionalObjectProperties), anns) {
+		return
+	}
+	s.allInverseFunctionalObjectProperties = append(s.allInverseFunctionalObjectProperties, x)
+	s.annotate(KindInverseFunctionalObjectProperty, len(s.allInverseFunctionalObjectProperties)-1, anns)
 }
 
 func (s *AxiomStore) StoreInverseObjectProperties(P1, P2 meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allInverseObjectProperties = append(s.allInverseObjectProperties, axioms.InverseObjectProperties{P1: P1, P2: P2})
+	x := axioms.InverseObjectProperties{P1: P1, P2: P2}
+	if s.duplicate(KindInverseObjectProperties, x, len(s.allInverseObjectProperties), anns) {
+		return
+	}
+	s.allInverseObjectProperties = append(s.allInverseObjectProperties, x)
+	s.annotate(KindInverseObjectProperties, len(s.allInverseObjectProperties)-1, anns)
 }
 
 func (s *AxiomStore) StoreIrreflexiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allIrreflexiveObjectProperties = append(s.allIrreflexiveObjectProperties, P)
+	x := P
+	if s.duplicate(KindIrreflexiveObjectProperty, x, len(s.allIrreflexiveObjectProperties), anns) {
+		return
+	}
+	s.allIrreflexiveObjectProperties = append(s.allIrreflexiveObjectProperties, x)
+	s.annotate(KindIrreflexiveObjectProperty, len(s.allIrreflexiveObjectProperties)-1, anns)
 }
 
 func (s *AxiomStore) StoreDataPropertyDomain(R meta.DataProperty, C meta.ClassExpression, anns []meta.Annotation) {
-	s.allDataPropertyDomains = append(s.allDataPropertyDomains, axioms.DataPropertyDomain{R: R, C: C})
+	x := axioms.DataPropertyDomain{R: R, C: C}
+	if s.duplicate(KindDataPropertyDomain, x, len(s.allDataPropertyDomains), anns) {
+		return
+	}
+	s.allDataPropertyDomains = append(s.allDataPropertyDomains, x)
+	s.annotate(KindDataPropertyDomain, len(s.allDataPropertyDomains)-1, anns)
 }
 
 func (s *AxiomStore) StoreDataPropertyRange(R meta.DataProperty, D meta.DataRange, anns []meta.Annotation) {
-	s.allDataPropertyRanges = append(s.allDataPropertyRanges, axioms.DataPropertyRange{R: R, D: D})
+	x := axioms.DataPropertyRange{R: R, D: D}
+	if s.duplicate(KindDataPropertyRange, x, len(s.allDataPropertyRanges), anns) {
+		return
+	}
+	s.allDataPropertyRanges = append(s.allDataPropertyRanges, x)
+	s.annotate(KindDataPropertyRange, len(s.allDataPropertyRanges)-1, anns)
+}
+
+func (s *AxiomStore) StoreDatatypeDefinition(DN meta.NamedDatatype, D meta.DataRange, anns []meta.Annotation) {
+	x := axioms.DatatypeDefinition{DN: DN, D: D}
+	if s.duplicate(KindDatatypeDefinition, x, len(s.allDatatypeDefinitions), anns) {
+		return
+	}
+	s.allDatatypeDefinitions = append(s.allDatatypeDefinitions, x)
+	s.annotate(KindDatatypeDefinition, len(s.allDatatypeDefinitions)-1, anns)
+	if dn, ok := DN.(*facets.CustomNamedDatatype); ok {
+		if _, exists := s.datatypeDefinitions[dn.DatatypeIRI]; !exists {
+			if s.datatypeDefinitions == nil {
+				s.datatypeDefinitions = map[string]meta.DataRange{}
+			}
+			s.datatypeDefinitions[dn.DatatypeIRI] = D
+		}
+	}
 }
 
 func (s *AxiomStore) StoreDisjointClasses(Cs []meta.ClassExpression, anns []meta.Annotation) {
-	s.allDisjointClasses = append(s.allDisjointClasses, axioms.DisjointClasses{DisjointClasses: Cs})
+	x := axioms.DisjointClasses{DisjointClasses: Cs}
+	if s.duplicate(KindDisjointClasses, x, len(s.allDisjointClasses), anns) {
+		return
+	}
+	s.allDisjointClasses = append(s.allDisjointClasses, x)
+	s.annotate(KindDisjointClasses, len(s.allDisjointClasses)-1, anns)
 }
 
-func (s *AxiomStore) StoreDifferentIndividuals(as []individual.Individual, anns []meta.Annotation) {
-	s.allDifferentIndividuals = append(s.allDifferentIndividuals, axioms.DifferentIndividuals{As: as})
+func (s *AxiomStore) StoreDisjointDataProperties(Rs []meta.DataProperty, anns []meta.Annotation) {
+	x := axioms.DisjointDataProperties{Rs: Rs}
+	if s.duplicate(KindDisjointDataProperties, x, len(s.allDisjointDataProperties), anns) {
+		return
+	}
+	s.allDisjointDataProperties = append(s.allDisjointDataProperties, x)
+	s.annotate(KindDisjointDataProperties, len(s.allDisjointDataProperties)-1, anns)
 }
 
-func (s *AxiomStore) StoreEquivalentClasses(Cs []meta.ClassExpression, anns []meta.Annotation) {
-	s.allEquivalentClasses = append(s.allEquivalentClasses, axioms.EquivalentClasses{EquivalentClasses: Cs})
+func (s *AxiomStore) StoreDisjointObjectProperties(Ps []meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	x := axioms.DisjointObjectProperties{Ps: Ps}
+	if s.duplicate(KindDisjointObjectProperties, x, len(s.allDisjointObjectProperties), anns) {
+		return
+	}
+	s.allDisjointObjectProperties = append(s.allDisjointObjectProperties, x)
+	s.annotate(KindDisjointObjectProperties, len(s.allDisjointObjectProperties)-1, anns)
 }
 
-func (s *AxiomStore) StoreNegativeObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual) {
-	s.allNegativeObjectPropertyAssertions = append(s.allNegativeObjectPropertyAssertions, assertions.NegativeObjectPropertyAssertion{P, a1, a2})
+func (s *AxiomStore) StoreDisjointUnion(CN meta.ClassExpression, Cs []meta.ClassExpression, anns []meta.Annotation) {
+	x := axioms.DisjointUnion{CN: CN, DisjointClasses: Cs}
+	if s.duplicate(KindDisjointUnion, x, len(s.allDisjointUnions), anns) {
+		return
+	}
+	s.allDisjointUnions = append(s.allDisjointUnions, x)
+	s.annotate(KindDisjointUnion, len(s.allDisjointUnions)-1, anns)
 }
 
-func (s *AxiomStore) StoreObjectPropertyAssertion(PN string, a1 individual.Individual, a2 individual.Individual) {
-	s.allObjectPropertyAssertions = append(s.allObjectPropertyAssertions, assertions.ObjectPropertyAssertion{PN, a1, a2})
+func (s *AxiomStore) StoreDifferentIndividuals(as []individual.Individual, anns []meta.Annotation) {
+	x := axioms.DifferentIndividuals{As: as}
+	if s.duplicate(KindDifferentIndividuals, x, len(s.allDifferentIndividuals), anns) {
+		return
+	}
+	s.allDifferentIndividuals = append(s.allDifferentIndividuals, x)
+	s.annotate(KindDifferentIndividuals, len(s.allDifferentIndividuals)-1, anns)
+}
+
+func (s *AxiomStore) StoreEquivalentClasses(Cs []meta.ClassExpression, anns []meta.Annotation) {
+	x := axioms.EquivalentClasses{EquivalentClasses: Cs}
+	if s.duplicate(KindEquivalentClasses, x, len(s.allEquivalentClasses), anns) {
+		return
+	}
+	s.allEquivalentClasses = append(s.allEquivalentClasses, x)
+	s.annotate(KindEquivalentClasses, len(s.allEquivalentClasses)-1, anns)
+}
+
+func (s *AxiomStore) StoreEquivalentDataProperties(Rs []meta.DataProperty, anns []meta.Annotation) {
+	x := axioms.EquivalentDataProperties{Rs: Rs}
+	if s.duplicate(KindEquivalentDataProperties, x, len(s.allEquivalentDataProperties), anns) {
+		return
+	}
+	s.allEquivalentDataProperties = append(s.allEquivalentDataProperties, x)
+	s.annotate(KindEquivalentDataProperties, len(s.allEquivalentDataProperties)-1, anns)
+}
+
+func (s *AxiomStore) StoreEquivalentObjectProperties(Ps []meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	x := axioms.EquivalentObjectProperties{Ps: Ps}
+	if s.duplicate(KindEquivalentObjectProperties, x, len(s.allEquivalentObjectProperties), anns) {
+		return
+	}
+	s.allEquivalentObjectProperties = append(s.allEquivalentObjectProperties, x)
+	s.annotate(KindEquivalentObjectProperties, len(s.allEquivalentObjectProperties)-1, anns)
+}
+
+func (s *AxiomStore) StoreNegativeDataPropertyAssertion(R meta.DataProperty, a individual.Individual, v literal.OWLLiteral, anns []meta.Annotation) {
+	x := assertions.NegativeDataPropertyAssertion{R: R, A: a, V: v}
+	if s.duplicate(KindNegativeDataPropertyAssertion, x, len(s.allNegativeDataPropertyAssertions), anns) {
+		return
+	}
+	s.allNegativeDataPropertyAssertions = append(s.allNegativeDataPropertyAssertions, x)
+	s.annotate(KindNegativeDataPropertyAssertion, len(s.allNegativeDataPropertyAssertions)-1, anns)
+}
+
+func (s *AxiomStore) StoreNegativeObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual, anns []meta.Annotation) {
+	x := assertions.NegativeObjectPropertyAssertion{P: P, A1: a1, A2: a2}
+	if s.duplicate(KindNegativeObjectPropertyAssertion, x, len(s.allNegativeObjectPropertyAssertions), anns) {
+		return
+	}
+	s.allNegativeObjectPropertyAssertions = append(s.allNegativeObjectPropertyAssertions, x)
+	s.annotate(KindNegativeObjectPropertyAssertion, len(s.allNegativeObjectPropertyAssertions)-1, anns)
+}
+
+func (s *AxiomStore) StoreObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual, anns []meta.Annotation) {
+	x := assertions.ObjectPropertyAssertion{P: P, A1: a1, A2: a2}
+	if s.duplicate(KindObjectPropertyAssertion, x, len(s.allObjectPropertyAssertions), anns) {
+		return
+	}
+	s.allObjectPropertyAssertions = append(s.allObjectPropertyAssertions, x)
+	s.annotate(KindObjectPropertyAssertion, len(s.allObjectPropertyAssertions)-1, anns)
 }
 
 func (s *AxiomStore) StoreObjectPropertyDomain(P meta.ObjectPropertyExpression, C meta.ClassExpression, anns []meta.Annotation) {
-	s.allObjectPropertyDomains = append(s.allObjectPropertyDomains, axioms.ObjectPropertyDomain{P: P, C: C})
+	x := axioms.ObjectPropertyDomain{P: P, C: C}
+	if s.duplicate(KindObjectPropertyDomain, x, len(s.allObjectPropertyDomains), anns) {
+		return
+	}
+	s.allObjectPropertyDomains = append(s.allObjectPropertyDomains, x)
+	s.annotate(KindObjectPropertyDomain, len(s.allObjectPropertyDomains)-1, anns)
 }
 
 func (s *AxiomStore) StoreObjectPropertyRange(P meta.ObjectPropertyExpression, C meta.ClassExpression, anns []meta.Annotation) {
-	s.allObjectPropertyRanges = append(s.allObjectPropertyRanges, axioms.ObjectPropertyRange{P: P, C: C})
+	x := axioms.ObjectPropertyRange{P: P, C: C}
+	if s.duplicate(KindObjectPropertyRange, x, len(s.allObjectPropertyRanges), anns) {
+		return
+	}
+	s.allObjectPropertyRanges = append(s.allObjectPropertyRanges, x)
+	s.annotate(KindObjectPropertyRange, len(s.allObjectPropertyRanges)-1, anns)
 }
 
 func (s *AxiomStore) StoreReflexiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allReflexiveObjectProperties = append(s.allReflexiveObjectProperties, P)
+	x := P
+	if s.duplicate(KindReflexiveObjectProperty, x, len(s.allReflexiveObjectProperties), anns) {
+		return
+	}
+	s.allReflexiveObjectProperties = append(s.allReflexiveObjectProperties, x)
+	s.annotate(KindReflexiveObjectProperty, len(s.allReflexiveObjectProperties)-1, anns)
+}
+
+func (s *AxiomStore) StoreSameIndividual(as []individual.Individual, anns []meta.Annotation) {
+	x := axioms.SameIndividual{As: as}
+	if s.duplicate(KindSameIndividual, x, len(s.allSameIndividuals), anns) {
+		return
+	}
+	s.allSameIndividuals = append(s.allSameIndividuals, x)
+	s.annotate(KindSameIndividual, len(s.allSameIndividuals)-1, anns)
 }
 
 func (s *AxiomStore) StoreSubAnnotationPropertyOf(A1, A2 string, anns []meta.Annotation) {
-	s.allSubAnnotationPropertyOfs = append(s.allSubAnnotationPropertyOfs, annotations.SubAnnotationPropertyOf{A1: A1, A2: A2})
+	x := annotations.SubAnnotationPropertyOf{A1: A1, A2: A2}
+	if s.duplicate(KindSubAnnotationPropertyOf, x, len(s.allSubAnnotationPropertyOfs), anns) {
+		return
+	}
+	s.allSubAnnotationPropertyOfs = append(s.allSubAnnotationPropertyOfs, x)
+	s.annotate(KindSubAnnotationPropertyOf, len(s.allSubAnnotationPropertyOfs)-1, anns)
 }
 
 func (s *AxiomStore) StoreSubClassOf(Csub, Csuper meta.ClassExpression, anns []meta.Annotation) {
-	s.allSubClassOfs = append(s.allSubClassOfs, axioms.SubClassOf{C1: Csub, C2: Csuper})
+	x := axioms.SubClassOf{C1: Csub, C2: Csuper}
+	if s.duplicate(KindSubClassOf, x, len(s.allSubClassOfs), anns) {
+		return
+	}
+	s.allSubClassOfs = append(s.allSubClassOfs, x)
+	s.annotate(KindSubClassOf, len(s.allSubClassOfs)-1, anns)
 }
 
 func (s *AxiomStore) StoreSubDataPropertyOf(P1, P2 meta.DataProperty, anns []meta.Annotation) {
-	s.allSubDataPropertyOfs = append(s.allSubDataPropertyOfs, axioms.SubDataPropertyOf{P1: P1, P2: P2})
+	x := axioms.SubDataPropertyOf{P1: P1, P2: P2}
+	if s.duplicate(KindSubDataPropertyOf, x, len(s.allSubDataPropertyOfs), anns) {
+		return
+	}
+	s.allSubDataPropertyOfs = append(s.allSubDataPropertyOfs, x)
+	s.annotate(KindSubDataPropertyOf, len(s.allSubDataPropertyOfs)-1, anns)
+}
+
+func (s *AxiomStore) StoreSubObjectPropertyChainOf(Chain []meta.ObjectPropertyExpression, P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	x := axioms.SubObjectPropertyChainOf{Chain: Chain, P: P}
+	if s.duplicate(KindSubObjectPropertyChainOf, x, len(s.allSubObjectPropertyChainOfs), anns) {
+		return
+	}
+	s.allSubObjectPropertyChainOfs = append(s.allSubObjectPropertyChainOfs, x)
+	s.annotate(KindSubObjectPropertyChainOf, len(s.allSubObjectPropertyChainOfs)-1, anns)
 }
 
 func (s *AxiomStore) StoreSubObjectPropertyOf(P1, P2 meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allSubObjectPropertyOfs = append(s.allSubObjectPropertyOfs, axioms.SubObjectPropertyOf{P1: P1, P2: P2})
+	x := axioms.SubObjectPropertyOf{P1: P1, P2: P2}
+	if s.duplicate(KindSubObjectPropertyOf, x, len(s.allSubObjectPropertyOfs), anns) {
+		return
+	}
+	s.allSubObjectPropertyOfs = append(s.allSubObjectPropertyOfs, x)
+	s.annotate(KindSubObjectPropertyOf, len(s.allSubObjectPropertyOfs)-1, anns)
 }
 
 func (s *AxiomStore) StoreSymmetricObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allSymmetricObjectProperties = append(s.allSymmetricObjectProperties, P)
+	x := P
+	if s.duplicate(KindSymmetricObjectProperty, x, len(s.allSymmetricObjectProperties), anns) {
+		return
+	}
+	s.allSymmetricObjectProperties = append(s.allSymmetricObjectProperties, x)
+	s.annotate(KindSymmetricObjectProperty, len(s.allSymmetricObjectProperties)-1, anns)
 }
 
 func (s *AxiomStore) StoreTransitiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
-	s.allTransitiveObjectProperties = append(s.allTransitiveObjectProperties, P)
+	x := P
+	if s.duplicate(KindTransitiveObjectProperty, x, len(s.allTransitiveObjectProperties), anns) {
+		return
+	}
+	s.allTransitiveObjectProperties = append(s.allTransitiveObjectProperties, x)
+	s.annotate(KindTransitiveObjectProperty, len(s.allTransitiveObjectProperties)-1, anns)
 }
 
 type DefaultAxiom struct {
@@ -0,0 +1,67 @@
+package storedefaults
+
+import (
+	"testing"
+
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/classexpression"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/owlfunctional/meta"
+)
+
+func TestAxiomStoreDuplicates(t *testing.T) {
+	s := NewAxiomStore()
+	A := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}
+	B := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:B"}}
+	C := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:C"}}
+	P := &decl.ObjectPropertyDecl{Declaration: decl.Declaration{IRI: "urn:p"}}
+	comment := &decl.AnnotationPropertyDecl{Declaration: decl.Declaration{IRI: "urn:comment"}}
+	ann1 := annotations.NewAnnotation(comment, `"1"`)
+	ann2 := annotations.NewAnnotation(comment, `"2"`)
+
+	s.StoreSubClassOf(A, B, nil)
+	s.StoreSubClassOf(C, B, nil)
+	// a structurally equal axiom, with other pointers
+	s.StoreSubClassOf(&decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}, B, []meta.Annotation{ann1})
+	s.StoreSubClassOf(A, B, []meta.Annotation{ann1, annotations.NewAnnotation(comment, `"2"`)})
+	s.StoreSubClassOf(B, A, nil)
+
+	subs := s.AllSubClassOfs()
+	if len(subs) != 3 || subs[0].C1 != A || subs[1].C1 != C || subs[2].C1 != B {
+		t.Fatal(subs)
+	}
+	if anns := s.AxiomAnnotations(KindSubClassOf, 0); len(anns) != 2 || anns[0] != ann1 || anns[1].T() != ann2.T() {
+		t.Fatal(anns)
+	}
+	if anns := s.AxiomAnnotations(KindSubClassOf, 1); anns != nil {
+		t.Fatal(anns)
+	}
+
+	// sets as arguments
+	s.StoreEquivalentClasses([]meta.ClassExpression{A, &classexpression.ObjectUnionOf{Cs: []meta.ClassExpression{B, C}}}, nil)
+	s.StoreEquivalentClasses([]meta.ClassExpression{&classexpression.ObjectUnionOf{Cs: []meta.ClassExpression{C, B}}, A}, nil)
+	if len(s.AllEquivalentClasses()) != 1 {
+		t.Fatal(s.AllEquivalentClasses())
+	}
+
+	// the same property in different kinds of axioms
+	s.StoreFunctionalObjectProperty(P, nil)
+	s.StoreSymmetricObjectProperty(P, nil)
+	s.StoreFunctionalObjectProperty(P, nil)
+	if len(s.AllFunctionalObjectProperties()) != 1 || len(s.AllSymmetricObjectProperties()) != 1 {
+		t.Fatal(s.AllFunctionalObjectProperties(), s.AllSymmetricObjectProperties())
+	}
+}
+
+func TestAxiomStoreAppendOnly(t *testing.T) {
+	s := NewAxiomStore()
+	s.AppendOnly = true
+	A := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:A"}}
+	B := &decl.ClassDecl{Declaration: decl.Declaration{IRI: "urn:B"}}
+
+	s.StoreSubClassOf(A, B, nil)
+	s.StoreSubClassOf(A, B, nil)
+	if len(s.AllSubClassOfs()) != 2 {
+		t.Fatal(s.AllSubClassOfs())
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/shful/gofp/owlfunctional/decl"
 	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
 	"github.com/shful/gofp/store"
 )
 
@@ -26,6 +27,14 @@ type DeclStore struct {
 	impNamedIndividualDecls    map[string]*decl.NamedIndividualDecl
 	impObjectPropertyDecls     map[string]*decl.ObjectPropertyDecl
 
+	// the *IRIs slices keep the order in which the declarations were first seen, explicit or implicit, for the All* methods.
+	annotationPropertyIRIs []string
+	classIRIs              []string
+	dataPropertyIRIs       []string
+	datatypeIRIs           []string
+	namedIndividualIRIs    []string
+	objectPropertyIRIs     []string
+
 	// Note: any Declaration can only be in the explicit, or in the implicit sets above, not in both.
 	// If an explicit declaration is found by the parser after implicit use, the declaration must me moved
 	// into the explicit set.
@@ -64,6 +73,7 @@ func (s *DeclStore) AnnotationPropertyDecl(iri string) (decl meta.AnnotationProp
 		decl, ok = s.impAnnotationPropertyDecls[iri]
 		if !ok {
 			s.impAnnotationPropertyDecls[iri] = newAnnotationPropertyDecl(iri)
+			s.annotationPropertyIRIs = append(s.annotationPropertyIRIs, iri)
 			decl, ok = s.impAnnotationPropertyDecls[iri]
 		}
 	}
@@ -76,6 +86,7 @@ func (s *DeclStore) ClassDecl(iri string) (decl meta.ClassExpression, ok bool) {
 		decl, ok = s.impClassDecls[iri]
 		if !ok {
 			s.impClassDecls[iri] = newClassDecl(iri)
+			s.classIRIs = append(s.classIRIs, iri)
 			decl, ok = s.impClassDecls[iri]
 		}
 	}
@@ -89,6 +100,7 @@ func (s *DeclStore) DataPropertyDecl(iri string) (decl meta.DataProperty, ok boo
 		decl, ok = s.impDataPropertyDecls[iri]
 		if !ok {
 			s.impDataPropertyDecls[iri] = newDataPropertyDecl(iri)
+			s.dataPropertyIRIs = append(s.dataPropertyIRIs, iri)
 			decl, ok = s.impDataPropertyDecls[iri]
 		}
 	}
@@ -102,6 +114,7 @@ func (s *DeclStore) DatatypeDecl(iri string) (decl meta.DataRange, ok bool) {
 		decl, ok = s.impDatatypeDecls[iri]
 		if !ok {
 			s.impDatatypeDecls[iri] = newDatatypeDecl(iri)
+			s.datatypeIRIs = append(s.datatypeIRIs, iri)
 			decl, ok = s.impDatatypeDecls[iri]
 		}
 	}
@@ -109,12 +122,13 @@ func (s *DeclStore) DatatypeDecl(iri string) (decl meta.DataRange, ok bool) {
 	return
 }
 
-func (s *DeclStore) NamedIndividualDecl(iri string) (decl interface{}, ok bool) {
+func (s *DeclStore) NamedIndividualDecl(iri string) (decl *decl.NamedIndividualDecl, ok bool) {
 	decl, ok = s.namedIndividualDecls[iri]
 	if !ok && !s.ExplicitDecls {
 		decl, ok = s.impNamedIndividualDecls[iri]
 		if !ok {
 			s.impNamedIndividualDecls[iri] = newNamedIndividualDecl(iri)
+			s.namedIndividualIRIs = append(s.namedIndividualIRIs, iri)
 			decl, ok = s.impNamedIndividualDecls[iri]
 		}
 	}
@@ -128,6 +142,7 @@ func (s *DeclStore) ObjectPropertyDecl(iri string) (decl meta.ObjectPropertyExpr
 		decl, ok = s.impObjectPropertyDecls[iri]
 		if !ok {
 			s.impObjectPropertyDecls[iri] = newObjectPropertyDecl(iri)
+			s.objectPropertyIRIs = append(s.objectPropertyIRIs, iri)
 			decl, ok = s.impObjectPropertyDecls[iri]
 		}
 	}
@@ -138,67 +153,75 @@ func (s *DeclStore) ObjectPropertyDecl(iri string) (decl meta.ObjectPropertyExpr
 // === End Get - methods ========
 
 // === All* - methods that return slices ========
+// The declarations are in the order of their first occurrence, where explicit and implicit declarations are mixed.
+
 func (s *DeclStore) AllAnnotationPropertyDecls() []*decl.AnnotationPropertyDecl {
-	res := make([]*decl.AnnotationPropertyDecl, 0, len(s.annotationPropertyDecls))
-	for _, v := range s.annotationPropertyDecls {
-		res = append(res, v)
-	}
-	for _, v := range s.impAnnotationPropertyDecls {
-		res = append(res, v)
+	res := make([]*decl.AnnotationPropertyDecl, 0, len(s.annotationPropertyIRIs))
+	for _, iri := range s.annotationPropertyIRIs {
+		if d, ok := s.annotationPropertyDecls[iri]; ok {
+			res = append(res, d)
+		} else {
+			res = append(res, s.impAnnotationPropertyDecls[iri])
+		}
 	}
 	return res
 }
 func (s *DeclStore) AllClassDecls() []*decl.ClassDecl {
-	res := make([]*decl.ClassDecl, 0, len(s.classDecls))
-	for _, v := range s.classDecls {
-		res = append(res, v)
-	}
-	for _, v := range s.impClassDecls {
-		res = append(res, v)
+	res := make([]*decl.ClassDecl, 0, len(s.classIRIs))
+	for _, iri := range s.classIRIs {
+		if d, ok := s.classDecls[iri]; ok {
+			res = append(res, d)
+		} else {
+			res = append(res, s.impClassDecls[iri])
+		}
 	}
 	return res
 }
 
 func (s *DeclStore) AllDataPropertyDecls() []*decl.DataPropertyDecl {
-	res := make([]*decl.DataPropertyDecl, 0, len(s.dataPropertyDecls))
-	for _, v := range s.dataPropertyDecls {
-		res = append(res, v)
-	}
-	for _, v := range s.impDataPropertyDecls {
-		res = append(res, v)
+	res := make([]*decl.DataPropertyDecl, 0, len(s.dataPropertyIRIs))
+	for _, iri := range s.dataPropertyIRIs {
+		if d, ok := s.dataPropertyDecls[iri]; ok {
+			res = append(res, d)
+		} else {
+			res = append(res, s.impDataPropertyDecls[iri])
+		}
 	}
 	return res
 }
 
 func (s *DeclStore) AllDatatypeDecls() []*decl.DatatypeDecl {
-	res := make([]*decl.DatatypeDecl, 0, len(s.datatypeDecls))
-	for _, v := range s.datatypeDecls {
-		res = append(res, v)
-	}
-	for _, v := range s.impDatatypeDecls {
-		res = append(res, v)
+	res := make([]*decl.DatatypeDecl, 0, len(s.datatypeIRIs))
+	for _, iri := range s.datatypeIRIs {
+		if d, ok := s.datatypeDecls[iri]; ok {
+			res = append(res, d)
+		} else {
+			res = append(res, s.impDatatypeDecls[iri])
+		}
 	}
 	return res
 }
 
 func (s *DeclStore) AllNamedIndividualDecls() []*decl.NamedIndividualDecl {
-	res := make([]*decl.NamedIndividualDecl, 0, len(s.namedIndividualDecls))
-	for _, v := range s.namedIndividualDecls {
-		res = append(res, v)
-	}
-	for _, v := range s.impNamedIndividualDecls {
-		res = append(res, v)
+	res := make([]*decl.NamedIndividualDecl, 0, len(s.namedIndividualIRIs))
+	for _, iri := range s.namedIndividualIRIs {
+		if d, ok := s.namedIndividualDecls[iri]; ok {
+			res = append(res, d)
+		} else {
+			res = append(res, s.impNamedIndividualDecls[iri])
+		}
 	}
 	return res
 }
 
 func (s *DeclStore) AllObjectPropertyDecls() []*decl.ObjectPropertyDecl {
-	res := make([]*decl.ObjectPropertyDecl, 0, len(s.objectPropertyDecls))
-	for _, v := range s.objectPropertyDecls {
-		res = append(res, v)
-	}
-	for _, v := range s.impObjectPropertyDecls {
-		res = append(res, v)
+	res := make([]*decl.ObjectPropertyDecl, 0, len(s.objectPropertyIRIs))
+	for _, iri := range s.objectPropertyIRIs {
+		if d, ok := s.objectPropertyDecls[iri]; ok {
+			res = append(res, d)
+		} else {
+			res = append(res, s.impObjectPropertyDecls[iri])
+		}
 	}
 	return res
 }
@@ -207,15 +230,21 @@ func (s *DeclStore) AllObjectPropertyDecls() []*decl.ObjectPropertyDecl {
 
 // === Store - methods to store explicit declarations =======
 
+// errDoubleExplicitDecl wraps parser.ErrDuplicateDeclaration, for errors.Is.
 func errDoubleExplicitDecl(iri string) error {
-	return fmt.Errorf("Repeated explicit declaration of %s", iri)
+	return fmt.Errorf("Repeated explicit declaration of %s (%w)", iri, parser.ErrDuplicateDeclaration)
 }
 
 func (s *DeclStore) StoreAnnotationPropertyDecl(iri string) (err error) {
 	if _, ok := s.annotationPropertyDecls[iri]; ok {
 		err = errDoubleExplicitDecl(iri)
+	} else if d, ok := s.impAnnotationPropertyDecls[iri]; ok {
+		// keep the instance which is already referenced by parsed axioms
+		s.annotationPropertyDecls[iri] = d
+		delete(s.impAnnotationPropertyDecls, iri)
 	} else {
 		s.annotationPropertyDecls[iri] = newAnnotationPropertyDecl(iri)
+		s.annotationPropertyIRIs = append(s.annotationPropertyIRIs, iri)
 	}
 	return
 }
@@ -223,8 +252,13 @@ func (s *DeclStore) StoreAnnotationPropertyDecl(iri string) (err error) {
 func (s *DeclStore) StoreClassDecl(iri string) (err error) {
 	if _, ok := s.classDecls[iri]; ok {
 		err = errDoubleExplicitDecl(iri)
+	} else if d, ok := s.impClassDecls[iri]; ok {
+		// keep the instance which is already referenced by parsed axioms
+		s.classDecls[iri] = d
+		delete(s.impClassDecls, iri)
 	} else {
 		s.classDecls[iri] = newClassDecl(iri)
+		s.classIRIs = append(s.classIRIs, iri)
 	}
 	return
 }
@@ -232,8 +266,13 @@ func (s *DeclStore) StoreClassDecl(iri string) (err error) {
 func (s *DeclStore) StoreDataPropertyDecl(iri string) (err error) {
 	if _, ok := s.dataPropertyDecls[iri]; ok {
 		err = errDoubleExplicitDecl(iri)
+	} else if d, ok := s.impDataPropertyDecls[iri]; ok {
+		// keep the instance which is already referenced by parsed axioms
+		s.dataPropertyDecls[iri] = d
+		delete(s.impDataPropertyDecls, iri)
 	} else {
 		s.dataPropertyDecls[iri] = newDataPropertyDecl(iri)
+		s.dataPropertyIRIs = append(s.dataPropertyIRIs, iri)
 	}
 	return
 }
@@ -241,8 +280,13 @@ func (s *DeclStore) StoreDataPropertyDecl(iri string) (err error) {
 func (s *DeclStore) StoreDatatypeDecl(iri string) (err error) {
 	if _, ok := s.datatypeDecls[iri]; ok {
 		err = errDoubleExplicitDecl(iri)
+	} else if d, ok := s.impDatatypeDecls[iri]; ok {
+		// keep the instance which is already referenced by parsed axioms
+		s.datatypeDecls[iri] = d
+		delete(s.impDatatypeDecls, iri)
 	} else {
 		s.datatypeDecls[iri] = newDatatypeDecl(iri)
+		s.datatypeIRIs = append(s.datatypeIRIs, iri)
 	}
 	return
 }
@@ -250,8 +294,13 @@ func (s *DeclStore) StoreDatatypeDecl(iri string) (err error) {
 func (s *DeclStore) StoreNamedIndividualDecl(iri string) (err error) {
 	if _, ok := s.namedIndividualDecls[iri]; ok {
 		err = errDoubleExplicitDecl(iri)
+	} else if d, ok := s.impNamedIndividualDecls[iri]; ok {
+		// keep the instance which is already referenced by parsed axioms
+		s.namedIndividualDecls[iri] = d
+		delete(s.impNamedIndividualDecls, iri)
 	} else {
 		s.namedIndividualDecls[iri] = newNamedIndividualDecl(iri)
+		s.namedIndividualIRIs = append(s.namedIndividualIRIs, iri)
 	}
 	return
 }
@@ -259,8 +308,13 @@ func (s *DeclStore) StoreNamedIndividualDecl(iri string) (err error) {
 func (s *DeclStore) StoreObjectPropertyDecl(iri string) (err error) {
 	if _, ok := s.objectPropertyDecls[iri]; ok {
 		err = errDoubleExplicitDecl(iri)
+	} else if d, ok := s.impObjectPropertyDecls[iri]; ok {
+		// keep the instance which is already referenced by parsed axioms
+		s.objectPropertyDecls[iri] = d
+		delete(s.impObjectPropertyDecls, iri)
 	} else {
 		s.objectPropertyDecls[iri] = newObjectPropertyDecl(iri)
+		s.objectPropertyIRIs = append(s.objectPropertyIRIs, iri)
 	}
 	return
 }
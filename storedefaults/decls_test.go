@@ -0,0 +1,35 @@
+package storedefaults
+
+import (
+	"testing"
+)
+
+func TestAllDeclsOrder(t *testing.T) {
+	s := NewDeclStore()
+	s.ExplicitDecls = false
+
+	s.StoreClassDecl("urn:D")
+	s.ClassDecl("urn:B") // implicit
+	s.StoreClassDecl("urn:C")
+	s.ClassDecl("urn:A") // implicit
+	s.StoreClassDecl("urn:B")
+	s.ClassDecl("urn:D")
+	s.StoreObjectPropertyDecl("urn:q")
+	s.ObjectPropertyDecl("urn:p")
+
+	for i := 0; i < 3; i++ {
+		var iris []string
+		for _, d := range s.AllClassDecls() {
+			iris = append(iris, d.IRI)
+		}
+		if len(iris) != 4 || iris[0] != "urn:D" || iris[1] != "urn:B" || iris[2] != "urn:C" || iris[3] != "urn:A" {
+			t.Fatal(iris)
+		}
+	}
+	if ps := s.AllObjectPropertyDecls(); len(ps) != 2 || ps[0].IRI != "urn:q" || ps[1].IRI != "urn:p" {
+		t.Fatal(ps)
+	}
+	if !s.ClassDeclExists("urn:B", false) || s.ClassDeclExists("urn:A", false) {
+		t.Fatal(s)
+	}
+}
@@ -0,0 +1,95 @@
+package storedefaults
+
+import "strconv"
+
+// AxiomKind identifies the kind of an axiom, which is one of the All*-methods of AllAxioms.
+// For example, KindSubClassOf stands for the axioms of AllSubClassOfs, and KindFunctionalObjectProperty for AllFunctionalObjectProperties.
+type AxiomKind int
+
+const (
+	KindAnnotationAssertion AxiomKind = iota
+	KindAnnotationPropertyDomain
+	KindAnnotationPropertyRange
+	KindAsymmetricObjectProperty
+	KindClassAssertion
+	KindDataPropertyAssertion
+	KindDataPropertyDomain
+	KindDataPropertyRange
+	KindDatatypeDefinition
+	KindDifferentIndividuals
+	KindDisjointClasses
+	KindDisjointDataProperties
+	KindDisjointObjectProperties
+	KindDisjointUnion
+	KindEquivalentClasses
+	KindEquivalentDataProperties
+	KindEquivalentObjectProperties
+	KindFunctionalDataProperty
+	KindFunctionalObjectProperty
+	KindHasKey
+	KindInverseFunctionalObjectProperty
+	KindInverseObjectProperties
+	KindIrreflexiveObjectProperty
+	KindNegativeDataPropertyAssertion
+	KindNegativeObjectPropertyAssertion
+	KindObjectPropertyAssertion
+	KindObjectPropertyDomain
+	KindObjectPropertyRange
+	KindReflexiveObjectProperty
+	KindSameIndividual
+	KindSubAnnotationPropertyOf
+	KindSubClassOf
+	KindSubDataPropertyOf
+	KindSubObjectPropertyChainOf
+	KindSubObjectPropertyOf
+	KindSymmetricObjectProperty
+	KindTransitiveObjectProperty
+)
+
+var axiomKindNames = [...]string{
+	KindAnnotationAssertion:             "AnnotationAssertion",
+	KindAnnotationPropertyDomain:        "AnnotationPropertyDomain",
+	KindAnnotationPropertyRange:         "AnnotationPropertyRange",
+	KindAsymmetricObjectProperty:        "AsymmetricObjectProperty",
+	KindClassAssertion:                  "ClassAssertion",
+	KindDataPropertyAssertion:           "DataPropertyAssertion",
+	KindDataPropertyDomain:              "DataPropertyDomain",
+	KindDataPropertyRange:               "DataPropertyRange",
+	KindDatatypeDefinition:              "DatatypeDefinition",
+	KindDifferentIndividuals:            "DifferentIndividuals",
+	KindDisjointClasses:                 "DisjointClasses",
+	KindDisjointDataProperties:          "DisjointDataProperties",
+	KindDisjointObjectProperties:        "DisjointObjectProperties",
+	KindDisjointUnion:                   "DisjointUnion",
+	KindEquivalentClasses:               "EquivalentClasses",
+	KindEquivalentDataProperties:        "EquivalentDataProperties",
+	KindEquivalentObjectProperties:      "EquivalentObjectProperties",
+	KindFunctionalDataProperty:          "FunctionalDataProperty",
+	KindFunctionalObjectProperty:        "FunctionalObjectProperty",
+	KindHasKey:                          "HasKey",
+	KindInverseFunctionalObjectProperty: "InverseFunctionalObjectProperty",
+	KindInverseObjectProperties:         "InverseObjectProperties",
+	KindIrreflexiveObjectProperty:       "IrreflexiveObjectProperty",
+	KindNegativeDataPropertyAssertion:   "NegativeDataPropertyAssertion",
+	KindNegativeObjectPropertyAssertion: "NegativeObjectPropertyAssertion",
+	KindObjectPropertyAssertion:         "ObjectPropertyAssertion",
+	KindObjectPropertyDomain:            "ObjectPropertyDomain",
+	KindObjectPropertyRange:             "ObjectPropertyRange",
+	KindReflexiveObjectProperty:         "ReflexiveObjectProperty",
+	KindSameIndividual:                  "SameIndividual",
+	KindSubAnnotationPropertyOf:         "SubAnnotationPropertyOf",
+	KindSubClassOf:                      "SubClassOf",
+	KindSubDataPropertyOf:               "SubDataPropertyOf",
+	KindSubObjectPropertyChainOf:        "SubObjectPropertyChainOf",
+	KindSubObjectPropertyOf:             "SubObjectPropertyOf",
+	KindSymmetricObjectProperty:         "SymmetricObjectProperty",
+	KindTransitiveObjectProperty:        "TransitiveObjectProperty",
+}
+
+// String returns the OWL-Functional name of the axiom kind, like "SubClassOf".
+func (k AxiomKind) String() string {
+	if k < 0 || int(k) >= len(axiomKindNames) {
+		return "AxiomKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return axiomKindNames[k]
+}
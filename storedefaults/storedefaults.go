@@ -13,6 +13,7 @@ import (
 )
 
 // AllAxioms are the methods to get slices of all parsed Axioms.
+// The slices are in the order in which the axioms were first stored, which is the order of the input (see AxiomStore for duplicates).
 type AllAxioms interface {
 	AllAnnotationAssertions() []annotations.AnnotationAssertion
 	AllAnnotationPropertyDomains() []annotations.AnnotationPropertyDomain
@@ -22,27 +23,47 @@ type AllAxioms interface {
 	AllDataPropertyAssertions() []axioms.DataPropertyAssertion
 	AllFunctionalDataProperties() []meta.DataProperty
 	AllFunctionalObjectProperties() []meta.ObjectPropertyExpression
+	AllHasKeys() []axioms.HasKey
 	AllInverseFunctionalObjectProperties() []meta.ObjectPropertyExpression
 	AllInverseObjectProperties() []axioms.InverseObjectProperties
 	AllIrreflexiveObjectProperties() []meta.ObjectPropertyExpression
+	AllNegativeDataPropertyAssertions() []assertions.NegativeDataPropertyAssertion
 	AllNegativeObjectPropertyAssertions() []assertions.NegativeObjectPropertyAssertion
 	AllDataPropertyDomains() []axioms.DataPropertyDomain
 	AllDataPropertyRanges() []axioms.DataPropertyRange
+	AllDatatypeDefinitions() []axioms.DatatypeDefinition
 	AllDisjointClasses() []axioms.DisjointClasses
+	AllDisjointDataProperties() []axioms.DisjointDataProperties
+	AllDisjointObjectProperties() []axioms.DisjointObjectProperties
+	AllDisjointUnions() []axioms.DisjointUnion
 	AllDifferentIndividuals() []axioms.DifferentIndividuals
 	AllEquivalentClasses() []axioms.EquivalentClasses
+	AllEquivalentDataProperties() []axioms.EquivalentDataProperties
+	AllEquivalentObjectProperties() []axioms.EquivalentObjectProperties
 	AllObjectPropertyAssertions() []assertions.ObjectPropertyAssertion
 	AllObjectPropertyDomains() []axioms.ObjectPropertyDomain
 	AllObjectPropertyRanges() []axioms.ObjectPropertyRange
 	AllReflexiveObjectProperties() []meta.ObjectPropertyExpression
+	AllSameIndividuals() []axioms.SameIndividual
+	AllSubAnnotationPropertyOfs() []annotations.SubAnnotationPropertyOf
 	AllSubClassOfs() []axioms.SubClassOf
 	AllSubDataPropertyOfs() []axioms.SubDataPropertyOf
+	AllSubObjectPropertyChainOfs() []axioms.SubObjectPropertyChainOf
 	AllSubObjectPropertyOfs() []axioms.SubObjectPropertyOf
 	AllSymmetricObjectProperties() []meta.ObjectPropertyExpression
 	AllTransitiveObjectProperties() []meta.ObjectPropertyExpression
 }
 
+// AnnotatedAxioms is optionally implemented by an AllAxioms which keeps the axiom annotations, like AxiomStore does.
+// Writers test for it with a type assertion, and write the axioms without annotations otherwise.
+type AnnotatedAxioms interface {
+	// AxiomAnnotations returns the annotations of the i-th axiom of the given kind, see AxiomStore.AxiomAnnotations.
+	AxiomAnnotations(kind AxiomKind, i int) []meta.Annotation
+}
+
 // AllDecls are the methods to get slices of all parsed Declarations.
+// The slices are in the order in which the declarations were first stored or requested, i.e. explicit and implicit
+// declarations are mixed in the order of their first occurrence in the input. The order is the same in each run.
 type AllDecls interface {
 	// All (as-slice) - methods:
 	AllAnnotationPropertyDecls() []*decl.AnnotationPropertyDecl
@@ -0,0 +1,325 @@
+// stream parses OWL-Functional documents without keeping the axioms in memory.
+// Each declaration and axiom is given to a callback as soon as it is parsed. Only the declarations are kept,
+// in a storedefaults.DeclStore, since the parser needs these to resolve IRIs. This allows processing huge ontologies,
+// e.g. to pipe the axioms into an own storage.
+package stream
+
+import (
+	"context"
+	"io"
+
+	"github.com/shful/gofp"
+	"github.com/shful/gofp/owlfunctional"
+	"github.com/shful/gofp/owlfunctional/annotations"
+	"github.com/shful/gofp/owlfunctional/assertions"
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/facets"
+	"github.com/shful/gofp/owlfunctional/individual"
+	"github.com/shful/gofp/owlfunctional/literal"
+	"github.com/shful/gofp/owlfunctional/meta"
+	"github.com/shful/gofp/owlfunctional/parser"
+	"github.com/shful/gofp/store"
+	"github.com/shful/gofp/storedefaults"
+)
+
+// Axiom is a single parsed axiom or declaration.
+type Axiom struct {
+	// Kind is the kind of the axiom, e.g. storedefaults.KindSubClassOf. It is not set for declarations, see IsDeclaration.
+	Kind storedefaults.AxiomKind
+
+	// IsDeclaration is true for a declaration, which has a Declaration as Value.
+	IsDeclaration bool
+
+	// Value is the axiom, with the same type as in the slices of storedefaults.AxiomStore, e.g. axioms.SubClassOf for KindSubClassOf.
+	// Object and data property characteristics have the wrapping axiom type as value, e.g. axioms.FunctionalObjectProperty
+	// for KindFunctionalObjectProperty, so that each value can be given to visit.Walk.
+	Value interface{}
+
+	// Annotations are the axiom annotations. nil if the axiom had none.
+	Annotations []meta.Annotation
+}
+
+// Declaration is the value of an Axiom with IsDeclaration set.
+type Declaration struct {
+	// Kind is the entity type, as in OWL-Functional, i.e. one of "AnnotationProperty", "Class", "DataProperty", "Datatype", "NamedIndividual" or "ObjectProperty".
+	Kind string
+
+	// IRI is the full IRI of the declared entity.
+	IRI string
+}
+
+// Handler is called for each parsed axiom. An error stops the parser, which then returns that error.
+type Handler func(x Axiom) error
+
+// OntologyFromReader parses the OWL-Functional document r, and calls handler for each declaration and axiom, in document order.
+// Implicit declarations are accepted, but only the explicit declarations are given to the handler.
+// The returned ontology has the prefixes, IRIs, imports and ontology annotations, but no axioms. Its K is nil.
+// sourceName: see parser.NewParser()
+func OntologyFromReader(r io.Reader, sourceName string, handler Handler) (ontology *owlfunctional.Ontology, err error) {
+	return ontologyFromParser(parser.NewParser(r, sourceName), handler)
+}
+
+// OntologyToChannel is like OntologyFromReader, but sends each declaration and axiom to ch.
+// ch is closed when parsing is done, also after an error.
+// Sending blocks until the axiom is received. A consumer which stops reading from ch must cancel ctx,
+// which stops the parser with an error.
+func OntologyToChannel(ctx context.Context, r io.Reader, sourceName string, ch chan<- Axiom) (ontology *owlfunctional.Ontology, err error) {
+	defer close(ch)
+	p := parser.NewParser(r, sourceName)
+	p.SetContext(ctx)
+	return ontologyFromParser(p, func(x Axiom) error {
+		select {
+		case ch <- x:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+func ontologyFromParser(p *parser.Parser, handler Handler) (ontology *owlfunctional.Ontology, err error) {
+	s := NewStore(handler)
+	s.ExplicitDecls = false
+
+	rc := owlfunctional.StoreConfig{
+		AxiomStore: s,
+		Decls:      s,
+		DeclStore:  s,
+	}
+	return gofp.OntologyFromParser(p, rc)
+}
+
+// Store implements the store interfaces of the parser. Axioms are not kept, but given to the handler.
+// Declarations are kept in the embedded DeclStore, and additionally given to the handler.
+// DatatypeDefinitions are kept, too, so that the parser can resolve custom datatypes.
+type Store struct {
+	*storedefaults.DeclStore
+
+	handler             Handler
+	err                 error
+	datatypeDefinitions map[string]meta.DataRange
+}
+
+var _ store.AxiomStore = (*Store)(nil)
+var _ store.DeclStore = (*Store)(nil)
+var _ store.Decls = (*Store)(nil)
+var _ store.DatatypeDefinitions = (*Store)(nil)
+var _ store.Failer = (*Store)(nil)
+
+func NewStore(handler Handler) *Store {
+	return &Store{
+		DeclStore:           storedefaults.NewDeclStore(),
+		handler:             handler,
+		datatypeDefinitions: map[string]meta.DataRange{},
+	}
+}
+
+// Err returns the first error of the handler. After an error, the handler is not called anymore.
+func (s *Store) Err() error {
+	return s.err
+}
+
+// DatatypeDefinition returns the data range of the first DatatypeDefinition for the datatype iri.
+func (s *Store) DatatypeDefinition(iri string) (D meta.DataRange, ok bool) {
+	D, ok = s.datatypeDefinitions[iri]
+	return
+}
+
+func (s *Store) emit(kind storedefaults.AxiomKind, value interface{}, anns []meta.Annotation) {
+	if len(anns) == 0 {
+		anns = nil
+	}
+	s.handle(Axiom{Kind: kind, Value: value, Annotations: anns})
+}
+
+func (s *Store) handle(x Axiom) {
+	if s.err != nil {
+		return
+	}
+	s.err = s.handler(x)
+}
+
+// declare gives a declaration to the handler, unless the DeclStore rejected it with err.
+func (s *Store) declare(kind, iri string, err error) error {
+	if err == nil {
+		s.handle(Axiom{IsDeclaration: true, Value: Declaration{Kind: kind, IRI: iri}})
+	}
+	return err
+}
+
+func (s *Store) StoreAnnotationPropertyDecl(iri string) error {
+	return s.declare("AnnotationProperty", iri, s.DeclStore.StoreAnnotationPropertyDecl(iri))
+}
+
+func (s *Store) StoreClassDecl(iri string) error {
+	return s.declare("Class", iri, s.DeclStore.StoreClassDecl(iri))
+}
+
+func (s *Store) StoreDataPropertyDecl(iri string) error {
+	return s.declare("DataProperty", iri, s.DeclStore.StoreDataPropertyDecl(iri))
+}
+
+func (s *Store) StoreDatatypeDecl(iri string) error {
+	return s.declare("Datatype", iri, s.DeclStore.StoreDatatypeDecl(iri))
+}
+
+func (s *Store) StoreNamedIndividualDecl(iri string) error {
+	return s.declare("NamedIndividual", iri, s.DeclStore.StoreNamedIndividualDecl(iri))
+}
+
+func (s *Store) StoreObjectPropertyDecl(iri string) error {
+	return s.declare("ObjectProperty", iri, s.DeclStore.StoreObjectPropertyDecl(iri))
+}
+
+func (s *Store) StoreAnnotationAssertion(A meta.AnnotationProperty, S string, t string, documentID int64, anns []meta.Annotation) {
+	s.emit(storedefaults.KindAnnotationAssertion, annotations.AnnotationAssertion{A: A, S: S, T: t, DocumentID: documentID}, anns)
+}
+
+func (s *Store) StoreAnnotationPropertyDomain(A meta.AnnotationProperty, U string, anns []meta.Annotation) {
+	s.emit(storedefaults.KindAnnotationPropertyDomain, annotations.AnnotationPropertyDomain{A: A, U: U}, anns)
+}
+
+func (s *Store) StoreAnnotationPropertyRange(A meta.AnnotationProperty, U string, anns []meta.Annotation) {
+	s.emit(storedefaults.KindAnnotationPropertyRange, annotations.AnnotationPropertyRange{A: A, U: U}, anns)
+}
+
+func (s *Store) StoreAsymmetricObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindAsymmetricObjectProperty, axioms.AsymmetricObjectProperty{P: P}, anns)
+}
+
+func (s *Store) StoreClassAssertion(C meta.ClassExpression, a individual.Individual, anns []meta.Annotation) {
+	s.emit(storedefaults.KindClassAssertion, axioms.ClassAssertion{C: C, A: a}, anns)
+}
+
+func (s *Store) StoreDataPropertyAssertion(R meta.DataProperty, a individual.Individual, v literal.OWLLiteral, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDataPropertyAssertion, axioms.DataPropertyAssertion{R: R, A: a, V: v}, anns)
+}
+
+func (s *Store) StoreFunctionalDataProperty(R meta.DataProperty, anns []meta.Annotation) {
+	s.emit(storedefaults.KindFunctionalDataProperty, axioms.FunctionalDataProperty{R: R}, anns)
+}
+
+func (s *Store) StoreFunctionalObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindFunctionalObjectProperty, axioms.FunctionalObjectProperty{P: P}, anns)
+}
+
+func (s *Store) StoreHasKey(C meta.ClassExpression, Ps []meta.ObjectPropertyExpression, Rs []meta.DataProperty, anns []meta.Annotation) {
+	s.emit(storedefaults.KindHasKey, axioms.HasKey{C: C, Ps: Ps, Rs: Rs}, anns)
+}
+
+func (s *Store) StoreInverseFunctionalObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindInverseFunctionalObjectProperty, axioms.InverseFunctionalObjectProperty{P: P}, anns)
+}
+
+func (s *Store) StoreInverseObjectProperties(P1, P2 meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindInverseObjectProperties, axioms.InverseObjectProperties{P1: P1, P2: P2}, anns)
+}
+
+func (s *Store) StoreIrreflexiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindIrreflexiveObjectProperty, axioms.IrreflexiveObjectProperty{P: P}, anns)
+}
+
+func (s *Store) StoreDataPropertyDomain(R meta.DataProperty, C meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDataPropertyDomain, axioms.DataPropertyDomain{R: R, C: C}, anns)
+}
+
+func (s *Store) StoreDataPropertyRange(R meta.DataProperty, D meta.DataRange, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDataPropertyRange, axioms.DataPropertyRange{R: R, D: D}, anns)
+}
+
+func (s *Store) StoreDatatypeDefinition(DN meta.NamedDatatype, D meta.DataRange, anns []meta.Annotation) {
+	if dn, isCustom := DN.(*facets.CustomNamedDatatype); isCustom {
+		if _, ok := s.datatypeDefinitions[dn.DatatypeIRI]; !ok {
+			s.datatypeDefinitions[dn.DatatypeIRI] = D
+		}
+	}
+	s.emit(storedefaults.KindDatatypeDefinition, axioms.DatatypeDefinition{DN: DN, D: D}, anns)
+}
+
+func (s *Store) StoreDisjointClasses(Cs []meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDisjointClasses, axioms.DisjointClasses{DisjointClasses: Cs}, anns)
+}
+
+func (s *Store) StoreDisjointDataProperties(Rs []meta.DataProperty, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDisjointDataProperties, axioms.DisjointDataProperties{Rs: Rs}, anns)
+}
+
+func (s *Store) StoreDisjointObjectProperties(Ps []meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDisjointObjectProperties, axioms.DisjointObjectProperties{Ps: Ps}, anns)
+}
+
+func (s *Store) StoreDisjointUnion(CN meta.ClassExpression, Cs []meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDisjointUnion, axioms.DisjointUnion{CN: CN, DisjointClasses: Cs}, anns)
+}
+
+func (s *Store) StoreDifferentIndividuals(as []individual.Individual, anns []meta.Annotation) {
+	s.emit(storedefaults.KindDifferentIndividuals, axioms.DifferentIndividuals{As: as}, anns)
+}
+
+func (s *Store) StoreEquivalentClasses(Cs []meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindEquivalentClasses, axioms.EquivalentClasses{EquivalentClasses: Cs}, anns)
+}
+
+func (s *Store) StoreEquivalentDataProperties(Rs []meta.DataProperty, anns []meta.Annotation) {
+	s.emit(storedefaults.KindEquivalentDataProperties, axioms.EquivalentDataProperties{Rs: Rs}, anns)
+}
+
+func (s *Store) StoreEquivalentObjectProperties(Ps []meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindEquivalentObjectProperties, axioms.EquivalentObjectProperties{Ps: Ps}, anns)
+}
+
+func (s *Store) StoreNegativeDataPropertyAssertion(R meta.DataProperty, a individual.Individual, v literal.OWLLiteral, anns []meta.Annotation) {
+	s.emit(storedefaults.KindNegativeDataPropertyAssertion, assertions.NegativeDataPropertyAssertion{R: R, A: a, V: v}, anns)
+}
+
+func (s *Store) StoreNegativeObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual, anns []meta.Annotation) {
+	s.emit(storedefaults.KindNegativeObjectPropertyAssertion, assertions.NegativeObjectPropertyAssertion{P: P, A1: a1, A2: a2}, anns)
+}
+
+func (s *Store) StoreObjectPropertyAssertion(P meta.ObjectPropertyExpression, a1 individual.Individual, a2 individual.Individual, anns []meta.Annotation) {
+	s.emit(storedefaults.KindObjectPropertyAssertion, assertions.ObjectPropertyAssertion{P: P, A1: a1, A2: a2}, anns)
+}
+
+func (s *Store) StoreObjectPropertyDomain(P meta.ObjectPropertyExpression, C meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindObjectPropertyDomain, axioms.ObjectPropertyDomain{P: P, C: C}, anns)
+}
+
+func (s *Store) StoreObjectPropertyRange(P meta.ObjectPropertyExpression, C meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindObjectPropertyRange, axioms.ObjectPropertyRange{P: P, C: C}, anns)
+}
+
+func (s *Store) StoreReflexiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindReflexiveObjectProperty, axioms.ReflexiveObjectProperty{P: P}, anns)
+}
+
+func (s *Store) StoreSameIndividual(as []individual.Individual, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSameIndividual, axioms.SameIndividual{As: as}, anns)
+}
+
+func (s *Store) StoreSubAnnotationPropertyOf(A1, A2 string, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSubAnnotationPropertyOf, annotations.SubAnnotationPropertyOf{A1: A1, A2: A2}, anns)
+}
+
+func (s *Store) StoreSubClassOf(Csub, Csuper meta.ClassExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSubClassOf, axioms.SubClassOf{C1: Csub, C2: Csuper}, anns)
+}
+
+func (s *Store) StoreSubDataPropertyOf(P1, P2 meta.DataProperty, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSubDataPropertyOf, axioms.SubDataPropertyOf{P1: P1, P2: P2}, anns)
+}
+
+func (s *Store) StoreSubObjectPropertyChainOf(Chain []meta.ObjectPropertyExpression, P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSubObjectPropertyChainOf, axioms.SubObjectPropertyChainOf{Chain: Chain, P: P}, anns)
+}
+
+func (s *Store) StoreSubObjectPropertyOf(P1, P2 meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSubObjectPropertyOf, axioms.SubObjectPropertyOf{P1: P1, P2: P2}, anns)
+}
+
+func (s *Store) StoreSymmetricObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindSymmetricObjectProperty, axioms.SymmetricObjectProperty{P: P}, anns)
+}
+
+func (s *Store) StoreTransitiveObjectProperty(P meta.ObjectPropertyExpression, anns []meta.Annotation) {
+	s.emit(storedefaults.KindTransitiveObjectProperty, axioms.TransitiveObjectProperty{P: P}, anns)
+}
@@ -0,0 +1,143 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shful/gofp/owlfunctional/axioms"
+	"github.com/shful/gofp/owlfunctional/decl"
+	"github.com/shful/gofp/storedefaults"
+)
+
+const pizzaOntology = `Prefix(:=<http://example.com/pizza#>)
+Prefix(rdfs:=<http://www.w3.org/2000/01/rdf-schema#>)
+Prefix(xsd:=<http://www.w3.org/2001/XMLSchema#>)
+
+Ontology(<http://example.com/pizza>
+	Annotation(rdfs:comment "Pizzas")
+	Declaration(Class(:Pizza))
+	Declaration(ObjectProperty(:hasTopping))
+	SubClassOf(Annotation(rdfs:comment "all of them") :Margherita :Pizza)
+	FunctionalObjectProperty(:hasTopping)
+	DatatypeDefinition(:calories DatatypeRestriction(xsd:integer xsd:minInclusive "0"^^xsd:integer))
+	DataPropertyRange(:hasCalories :calories)
+	ClassAssertion(:Pizza :Diavolo)
+)
+`
+
+func TestOntologyFromReader(t *testing.T) {
+	var got []Axiom
+	o, err := OntologyFromReader(strings.NewReader(pizzaOntology), "test", func(x Axiom) error {
+		got = append(got, x)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.IRI != "<http://example.com/pizza>" || len(o.Annotations()) != 1 || o.K != nil {
+		t.Fatal(o.IRI, o.Annotations(), o.K)
+	}
+
+	var kinds []string
+	for _, x := range got {
+		if x.IsDeclaration {
+			kinds = append(kinds, "Declaration")
+		} else {
+			kinds = append(kinds, x.Kind.String())
+		}
+	}
+	expected := "Declaration Declaration SubClassOf FunctionalObjectProperty DatatypeDefinition DataPropertyRange ClassAssertion"
+	if strings.Join(kinds, " ") != expected {
+		t.Fatal(kinds)
+	}
+
+	if d := got[1].Value.(Declaration); d.Kind != "ObjectProperty" || d.IRI != "http://example.com/pizza#hasTopping" {
+		t.Fatal(d)
+	}
+	sub := got[2].Value.(axioms.SubClassOf)
+	if sub.C1.(*decl.ClassDecl).IRI != "http://example.com/pizza#Margherita" || len(got[2].Annotations) != 1 {
+		t.Fatal(sub, got[2].Annotations)
+	}
+	if f, ok := got[3].Value.(axioms.FunctionalObjectProperty); !ok || f.P.(*decl.ObjectPropertyDecl).IRI != "http://example.com/pizza#hasTopping" || got[3].Annotations != nil {
+		t.Fatal(got[3])
+	}
+}
+
+func TestHandlerError(t *testing.T) {
+	stop := errors.New("storage full")
+	var count int
+	_, err := OntologyFromReader(strings.NewReader(pizzaOntology), "test", func(x Axiom) error {
+		count++
+		if !x.IsDeclaration && x.Kind == storedefaults.KindSubClassOf {
+			return stop
+		}
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "storage full") {
+		t.Fatal(err)
+	}
+	if count != 3 {
+		t.Fatal(count)
+	}
+}
+
+func TestOntologyToChannel(t *testing.T) {
+	ch := make(chan Axiom)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := OntologyToChannel(context.Background(), strings.NewReader(pizzaOntology), "test", ch)
+		errs <- err
+	}()
+
+	var count int
+	for range ch {
+		count++
+	}
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+	if count != 7 {
+		t.Fatal(count)
+	}
+}
+
+func TestOntologyToChannelCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan Axiom)
+	errs := make(chan error, 1)
+	go func() {
+		_, err := OntologyToChannel(ctx, strings.NewReader(pizzaOntology), "test", ch)
+		errs <- err
+	}()
+
+	// the consumer stops after the first axiom
+	<-ch
+	cancel()
+	if err := <-errs; err == nil {
+		t.Fatal("expected an error")
+	}
+	if _, open := <-ch; open {
+		t.Fatal("channel not closed")
+	}
+}
+
+func TestRejectedDeclaration(t *testing.T) {
+	var count int
+	_, err := OntologyFromReader(strings.NewReader(`Prefix(:=<http://example.com/pizza#>)
+Ontology(
+	Declaration(Class(:Pizza))
+	Declaration(Class(:Pizza))
+)`), "test", func(x Axiom) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the repeated declaration is rejected by the DeclStore, and not given to the handler
+	if count != 1 {
+		t.Fatal(count)
+	}
+}